@@ -1,12 +1,11 @@
 package app
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"sort"
+	"fmt"
 
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/pkg/consts"
@@ -16,12 +15,20 @@ import (
 
 // PreprocessTxs fullfills the celestia-core version of the ACBI interface, by
 // performing basic validation for the incoming txs, and by cleanly separating
-// share messages from transactions
+// share messages from transactions. The square size of the block is the
+// smallest of the square sizes in the payment params that fits the txs. The
+// txs are validated against the state committed by the last block, so the
+// first block, which is proposed before the genesis state is committed, is
+// empty.
 func (app *App) PreprocessTxs(txs abci.RequestPreprocessTxs) abci.ResponsePreprocessTxs {
-	squareSize := app.SquareSize()
-	shareCounter := uint64(0)
-	var shareMsgs []*core.Message
-	var processedTxs [][]byte
+	if app.LastBlockHeight() == 0 {
+		return abci.ResponsePreprocessTxs{Messages: &core.Messages{}}
+	}
+
+	ctx := app.committedContext()
+	params := app.PaymentKeeper.GetParams(ctx)
+	fees := newFeeTracker(app, ctx)
+	var pending []pendingTx
 	for _, rawTx := range txs.Txs {
 		// decode the Tx
 		tx, err := app.txConfig.TxDecoder()(rawTx)
@@ -37,7 +44,8 @@ func (app *App) PreprocessTxs(txs abci.RequestPreprocessTxs) abci.ResponsePrepro
 		// don't process the tx if the transaction doesn't contain a
 		//  MsgPayForMessage sdk.Msg
 		if !hasWirePayForMessage(authTx) {
-			processedTxs = append(processedTxs, rawTx)
+			fees.spend(authTx, nil)
+			pending = append(pending, pendingTx{rawTx: rawTx, authTx: authTx})
 			continue
 		}
 
@@ -46,79 +54,203 @@ func (app *App) PreprocessTxs(txs abci.RequestPreprocessTxs) abci.ResponsePrepro
 			continue
 		}
 
-		msg := authTx.GetMsgs()[0]
-		wireMsg, ok := msg.(*types.MsgWirePayForMessage)
-		if !ok {
-			continue
-		}
-
 		// run basic validation on the transaction
 		err = authTx.ValidateBasic()
 		if err != nil {
 			continue
 		}
 
-		// parse wire message and create a single message
-		coreMsg, unsignedPFM, sig, err := types.ProcessWirePayForMessage(wireMsg, app.SquareSize())
-		if err != nil {
+		wireMsg := authTx.GetMsgs()[0]
+		if err := app.validateWireMsg(ctx, params, wireMsg); err != nil {
 			continue
 		}
 
-		// create the signed PayForMessage using the fees, gas limit, and sequence from
-		// the original transaction, along with the appropriate signature.
-		signedTx, err := types.BuildPayForMessageTxFromWireTx(authTx, app.txConfig.NewTxBuilder(), sig, unsignedPFM)
-		if err != nil {
-			app.Logger().Error("failure to create signed PayForMessage", err)
+		// the message fee is charged when the malleated tx is delivered, after
+		// the messages are already in the block, so the signer must be able to
+		// pay it on top of the fees of its previous txs
+		if !fees.spend(authTx, wireMsg) {
 			continue
 		}
 
-		// increment the share counter by the number of shares taken by the message
-		sharesTaken := uint64(len(coreMsg.Data) / types.ShareSize)
-		shareCounter += sharesTaken
+		pending = append(pending, pendingTx{rawTx: rawTx, authTx: authTx, wireMsg: wireMsg})
+	}
+
+	// celestia-core adds the pending evidence to the block after
+	// PreprocessTxs, and doesn't pass it in the request, so there is no
+	// evidence to reserve shares for yet
+	data := app.selectSquareSize(params.SquareSizes, 0, pending)
 
-		// if there are too many shares stop processing and return the transactions
-		if shareCounter > squareSize*squareSize {
-			break
+	// the other validators can't check the messages of the block, so the
+	// proposer makes sure that they are valid
+	blockData := &core.Data{Txs: data.txs, Messages: core.Messages{MessagesList: data.msgs}}
+	if err := app.ValidateBlockData(blockData); err != nil {
+		app.Logger().Error("proposed invalid block data, leaving out the txs that pay for messages", "err", err)
+		var regularTxs []pendingTx
+		for _, ptx := range pending {
+			if ptx.wireMsg == nil {
+				regularTxs = append(regularTxs, ptx)
+			}
 		}
+		data = app.selectSquareSize(params.SquareSizes, 0, regularTxs)
+	}
+
+	// celestia-core derives the width of the square from the shares of the
+	// block data, and the block data was built so that it fits exactly the
+	// selected square size
+	app.squareLayout = data.layout
+
+	return abci.ResponsePreprocessTxs{
+		Txs:      data.txs,
+		Messages: &core.Messages{MessagesList: data.msgs},
+	}
+}
+
+// BeginBlock fulfills the ABCI interface, and starts checking the malleated
+// txs of the new block as they are delivered
+func (app *App) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.deliveredTxs = newMalleatedTxValidator(app.txConfig.TxDecoder())
+	return app.BaseApp.BeginBlock(req)
+}
+
+// DeliverTx fulfills the ABCI interface. The messages of the block are not
+// passed to the application, so only the malleated txs are checked against
+// the rules of ValidateBlockData. A malleated tx that breaks them is rejected,
+// and the other txs are checked by the ante handler.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	if parentHash, childTx, isMalleated := coretypes.UnwrapMalleatedTx(req.Tx); isMalleated {
+		if app.deliveredTxs == nil {
+			app.deliveredTxs = newMalleatedTxValidator(app.txConfig.TxDecoder())
+		}
+		if _, err := app.deliveredTxs.validate(parentHash, childTx); err != nil {
+			return sdkerrors.ResponseDeliverTx(err, 0, 0, false)
+		}
+	}
+	return app.BaseApp.DeliverTx(req)
+}
 
-		rawProcessedTx, err := app.txConfig.TxEncoder()(signedTx)
+// committedContext returns a context that reads the state committed by the
+// last block. Unlike the check state, it doesn't include the changes made by
+// the txs in the mempool. Writes to the context are discarded.
+func (app *App) committedContext() sdk.Context {
+	ctx := app.NewUncachedContext(true, core.Header{Height: app.LastBlockHeight()})
+	return ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+}
+
+// validateWireMsg validates a MsgWirePayForMessage or MsgWirePayForMessages
+// against the current state
+func (app *App) validateWireMsg(ctx sdk.Context, params types.Params, msg sdk.Msg) error {
+	switch wireMsg := msg.(type) {
+	case *types.MsgWirePayForMessage:
+		// check the message against the current params of the payment module
+		err := wireMsg.ValidateWithParams(params)
 		if err != nil {
-			continue
+			return err
 		}
 
-		parentHash := sha256.Sum256(rawTx)
-		wrappedTx, err := coretypes.WrapMalleatedTx(parentHash[:], rawProcessedTx)
+		// only the owner and writers can pay for messages in a registered namespace
+		if !app.PaymentKeeper.CanWrite(ctx, wireMsg.MessageNameSpaceId, wireMsg.Signer) {
+			return types.ErrNamespaceUnauthorized
+		}
+		return nil
+
+	case *types.MsgWirePayForMessages:
+		// check the messages against the current params of the payment module
+		err := wireMsg.ValidateWithParams(params)
 		if err != nil {
-			app.Logger().Error("failure to wrap child transaction with parent hash", "Error:", err)
+			return err
+		}
+
+		// only the owner and writers can pay for messages in a registered namespace
+		for _, m := range wireMsg.Messages {
+			if !app.PaymentKeeper.CanWrite(ctx, m.NamespaceId, wireMsg.Signer) {
+				return types.ErrNamespaceUnauthorized
+			}
 		}
+		return nil
 
-		shareMsgs = append(shareMsgs, coreMsg)
-		processedTxs = append(processedTxs, wrappedTx)
+	default:
+		return fmt.Errorf("unexpected wire message type: %T", msg)
 	}
+}
+
+// feeTracker keeps track of the fees that the txs accepted by PreprocessTxs
+// spend from each account, so that wire txs whose signers can't pay the
+// message fee are left out of the block. Other spendings of the txs in the
+// block are not known before they are delivered.
+type feeTracker struct {
+	app     *App
+	ctx     sdk.Context
+	balance map[string]sdk.Coins
+}
 
-	// sort messages lexigraphically
-	sort.Slice(shareMsgs, func(i, j int) bool {
-		return bytes.Compare(shareMsgs[i].NamespaceId, shareMsgs[j].NamespaceId) < 0
-	})
+func newFeeTracker(app *App, ctx sdk.Context) *feeTracker {
+	return &feeTracker{app: app, ctx: ctx, balance: make(map[string]sdk.Coins)}
+}
 
-	return abci.ResponsePreprocessTxs{
-		Txs:      processedTxs,
-		Messages: &core.Messages{MessagesList: shareMsgs},
+// spend deducts the fee of the tx and the message fee of the wire msg from the
+// remaining balances of their payers. If a payer can't afford them, nothing
+// is deducted and false is returned. The tx fee is not tracked if it is paid
+// by a fee granter.
+func (ft *feeTracker) spend(tx signing.Tx, wireMsg sdk.Msg) bool {
+	costs := make(map[string]sdk.Coins)
+	if tx.FeeGranter().Empty() {
+		costs[tx.FeePayer().String()] = tx.GetFee()
 	}
+	if wireMsg != nil {
+		signer := wireMsg.GetSigners()[0].String()
+		costs[signer] = costs[signer].Add(ft.app.PaymentKeeper.MsgMessageFee(ft.ctx, wireMsg)...)
+	}
+
+	remaining := make(map[string]sdk.Coins, len(costs))
+	for addr, cost := range costs {
+		balance, ok := ft.balance[addr]
+		if !ok {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				return false
+			}
+			balance = ft.app.BankKeeper.SpendableCoins(ft.ctx, acc)
+		}
+		left, negative := balance.SafeSub(cost)
+		if negative {
+			// only wire txs are left out, the other txs already passed
+			// CheckTx and are charged regardless
+			if wireMsg != nil {
+				return false
+			}
+			left = sdk.NewCoins()
+		}
+		remaining[addr] = left
+	}
+
+	for addr, left := range remaining {
+		ft.balance[addr] = left
+	}
+	return true
 }
 
 func hasWirePayForMessage(tx sdk.Tx) bool {
 	for _, msg := range tx.GetMsgs() {
 		msgName := sdk.MsgTypeURL(msg)
-		if msgName == types.URLMsgWirePayforMessage {
+		if msgName == types.URLMsgWirePayforMessage || msgName == types.URLMsgWirePayForMessages {
 			return true
 		}
 	}
 	return false
 }
 
-// SquareSize returns the current square size. Currently, the square size is
-// hardcoded. todo(evan): don't hardcode the square size
+// SquareSize returns the square size selected for the last block proposed by
+// PreprocessTxs, or the max square size if no block has been proposed yet
 func (app *App) SquareSize() uint64 {
-	return consts.MaxSquareSize
+	if app.squareLayout.SquareSize == 0 {
+		return consts.MaxSquareSize
+	}
+	return app.squareLayout.SquareSize
+}
+
+// SquareLayout returns the layout of the shares of the last block proposed by
+// PreprocessTxs. The indexes of the messages skip the empty padding messages
+// of the block.
+func (app *App) SquareLayout() types.SquareLayout {
+	return app.squareLayout
 }
\ No newline at end of file
@@ -0,0 +1,70 @@
+package app
+
+import (
+	paymentante "github.com/celestiaorg/celestia-app/x/payment/ante"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options by requiring the
+// payment keeper and the tx config.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	PaymentKeeper paymentante.PaymentKeeper
+	TxConfig      client.TxConfig
+}
+
+// NewAnteHandler returns the default SDK AnteHandler with the payment module's
+// decorators added to it.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.PaymentKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "payment keeper is required for ante builder")
+	}
+
+	if options.TxConfig == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx config is required for ante builder")
+	}
+
+	var sigGasConsumer = options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		paymentante.NewMalleatedTxDecorator(),
+		paymentante.NewParamsDecorator(options.PaymentKeeper),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		paymentante.NewMessageGasDecorator(options.PaymentKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		paymentante.NewMessageFeeDecorator(options.PaymentKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		paymentante.NewCommitmentSignatureDecorator(options.AccountKeeper, options.TxConfig),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -131,6 +131,7 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		paymentmoduletypes.ModuleName:  {authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -162,6 +163,14 @@ type App struct {
 
 	invCheckPeriod uint
 
+	// squarePacker decides which txs are included when proposing a block
+	squarePacker SquarePacker
+	// squareLayout is the layout of the last proposed block
+	squareLayout paymentmoduletypes.SquareLayout
+	// deliveredTxs checks the malleated txs of the current block as they are
+	// delivered
+	deliveredTxs *malleatedTxValidator
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -241,6 +250,7 @@ func New(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		squarePacker:      squarePackerFromOptions(appOpts),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -284,10 +294,20 @@ func New(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
+	// the qgb keeper is created before the staking hooks are registered, since
+	// it uses them to track unbonding validators
+	app.QgbKeeper = *qgbmodulekeeper.NewKeeper(
+		appCodec,
+		&stakingKeeper,
+		keys[qgbmoduletypes.StoreKey],
+		keys[qgbmoduletypes.MemStoreKey],
+		app.GetSubspace(qgbmoduletypes.ModuleName),
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.QgbKeeper.Hooks()),
 	)
 
 	// ... other modules keepers
@@ -315,16 +335,13 @@ func New(
 	app.PaymentKeeper = *paymentmodulekeeper.NewKeeper(
 		appCodec,
 		app.BankKeeper,
+		app.StakingKeeper,
 		keys[paymentmoduletypes.StoreKey],
 		keys[paymentmoduletypes.MemStoreKey],
+		app.GetSubspace(paymentmoduletypes.ModuleName),
 	)
 	paymentmodule := paymentmodule.NewAppModule(appCodec, app.PaymentKeeper)
 
-	app.QgbKeeper = *qgbmodulekeeper.NewKeeper(
-		appCodec,
-		keys[qgbmoduletypes.StoreKey],
-		keys[qgbmoduletypes.MemStoreKey],
-	)
 	qgbmodule := qgbmodule.NewAppModule(appCodec, app.QgbKeeper)
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
@@ -379,7 +396,9 @@ func New(
 		feegrant.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, stakingtypes.ModuleName)
+	// the qgb module must run after staking, so that valsets are created from
+	// the updated validator set
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, stakingtypes.ModuleName, qgbmoduletypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -417,13 +436,17 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			PaymentKeeper: app.PaymentKeeper,
+			TxConfig:      encodingConfig.TxConfig,
 		},
 	)
 	if err != nil {
@@ -583,7 +606,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
-	paramsKeeper.Subspace(paymentmoduletypes.ModuleName)
+	paramsKeeper.Subspace(paymentmoduletypes.ModuleName).WithKeyTable(paymentmoduletypes.ParamKeyTable())
 	paramsKeeper.Subspace(qgbmoduletypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
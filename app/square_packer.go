@@ -0,0 +1,233 @@
+package app
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// FlagSquarePacker is the app option used to select the strategy that the
+	// proposer uses to pack txs into the square
+	FlagSquarePacker = "square-packer"
+
+	// FeePriorityPackerName is the name of the FeePriorityPacker strategy
+	FeePriorityPackerName = "fee-priority"
+	// MempoolOrderPackerName is the name of the MempoolOrderPacker strategy
+	MempoolOrderPackerName = "mempool-order"
+)
+
+// PackableTx is a tx that can be included in a square of a given size, along
+// with the messages that it pays for.
+type PackableTx struct {
+	// Tx is the tx as it is included in the block
+	Tx []byte
+	// Messages are the messages paid for by the tx
+	Messages []*core.Message
+	// Fee is the fee paid by the tx
+	Fee sdk.Coins
+	// Signers are the addresses that sign the tx
+	Signers []string
+}
+
+// SquarePacker decides which of the pending txs are included in a square of
+// the provided size, in which the provided number of shares is already used
+// by evidence. The txs are provided in mempool order, and the returned
+// txs are included in the order that they are returned. Txs that do not fit in
+// the square are left out by PreprocessTxs, along with the txs that follow
+// them from the same signers, whose sequences would no longer be valid.
+type SquarePacker interface {
+	Pack(squareSize, evidenceShares uint64, txs []PackableTx) []PackableTx
+}
+
+// MempoolOrderPacker includes txs in mempool order, and stops once a tx doesn't
+// fit in the square
+type MempoolOrderPacker struct{}
+
+// Pack fulfills the SquarePacker interface
+func (MempoolOrderPacker) Pack(squareSize, evidenceShares uint64, txs []PackableTx) []PackableTx {
+	builder := types.NewSquareLayoutBuilder(squareSize, evidenceShares)
+	for i, tx := range txs {
+		if !builder.TryAdd(tx.Tx, tx.Messages) {
+			return txs[:i]
+		}
+	}
+	return txs
+}
+
+// FeePriorityPacker includes every tx that doesn't pay for messages, followed
+// by the txs that pay for messages with the highest fee per share. Txs that
+// don't fit are skipped, along with the later txs of their signers. A tx is
+// only considered once the previous txs of its signers are included, and the
+// included txs keep their mempool order, so that txs from the same signer are
+// included in order of their sequence.
+type FeePriorityPacker struct {
+	// Denom is the denom of the fees used to rank the txs
+	Denom string
+}
+
+// NewFeePriorityPacker returns a FeePriorityPacker that ranks txs by the fees
+// paid in the provided denom
+func NewFeePriorityPacker(denom string) FeePriorityPacker {
+	return FeePriorityPacker{Denom: denom}
+}
+
+// Pack fulfills the SquarePacker interface
+func (p FeePriorityPacker) Pack(squareSize, evidenceShares uint64, txs []PackableTx) []PackableTx {
+	// waiting counts the previous txs of the signers of each tx that are not
+	// included yet, and next links each tx to the following txs of its
+	// signers
+	waiting := make([]int, len(txs))
+	next := make([][]int, len(txs))
+	last := make(map[string]int)
+	for i, tx := range txs {
+		for _, signer := range tx.Signers {
+			if prev, ok := last[signer]; ok {
+				next[prev] = append(next[prev], i)
+				waiting[i]++
+			}
+			last[signer] = i
+		}
+	}
+
+	// rank the txs that can be included, starting with the txs that don't pay
+	// for messages
+	queue := &txQueue{
+		hasMessages: make([]bool, len(txs)),
+		feePerShare: make([]sdk.Dec, len(txs)),
+	}
+	for i, tx := range txs {
+		queue.hasMessages[i] = len(tx.Messages) != 0
+		if queue.hasMessages[i] {
+			queue.feePerShare[i] = p.feePerShare(tx)
+		}
+		if waiting[i] == 0 {
+			queue.txs = append(queue.txs, i)
+		}
+	}
+	heap.Init(queue)
+
+	// greedily pick txs, skipping the ones that don't fit. The later txs of
+	// the signers of a skipped tx keep waiting, so they are skipped too.
+	builder := types.NewSquareLayoutBuilder(squareSize, evidenceShares)
+	picked := make([]bool, len(txs))
+	for queue.Len() > 0 {
+		i := heap.Pop(queue).(int)
+		if !builder.TryAdd(txs[i].Tx, txs[i].Messages) {
+			continue
+		}
+		picked[i] = true
+		for _, j := range next[i] {
+			waiting[j]--
+			if waiting[j] == 0 {
+				heap.Push(queue, j)
+			}
+		}
+	}
+
+	out := make([]PackableTx, 0, len(txs))
+	for i, tx := range txs {
+		if picked[i] {
+			out = append(out, tx)
+		}
+	}
+	return out
+}
+
+// feePerShare returns the fee paid for each share of the tx's messages
+func (p FeePriorityPacker) feePerShare(tx PackableTx) sdk.Dec {
+	shares := uint64(0)
+	for _, msg := range tx.Messages {
+		shares += types.MessageSharesUsed(len(msg.Data))
+	}
+	return tx.Fee.AmountOf(p.Denom).ToDec().QuoInt64(int64(shares))
+}
+
+// txQueue is a priority queue of the indexes of txs. Txs that don't pay for
+// messages come first, followed by the txs with the highest fee per share.
+// Ties are broken by mempool order.
+type txQueue struct {
+	txs         []int
+	hasMessages []bool
+	feePerShare []sdk.Dec
+}
+
+func (q *txQueue) Len() int { return len(q.txs) }
+
+func (q *txQueue) Less(i, j int) bool {
+	a, b := q.txs[i], q.txs[j]
+	if q.hasMessages[a] != q.hasMessages[b] {
+		return !q.hasMessages[a]
+	}
+	if q.hasMessages[a] && !q.feePerShare[a].Equal(q.feePerShare[b]) {
+		return q.feePerShare[a].GT(q.feePerShare[b])
+	}
+	return a < b
+}
+
+func (q *txQueue) Swap(i, j int) { q.txs[i], q.txs[j] = q.txs[j], q.txs[i] }
+
+func (q *txQueue) Push(x interface{}) { q.txs = append(q.txs, x.(int)) }
+
+func (q *txQueue) Pop() interface{} {
+	n := len(q.txs)
+	i := q.txs[n-1]
+	q.txs = q.txs[:n-1]
+	return i
+}
+
+// FitsSquare returns true if the txs and their messages fit in a square of the
+// provided size along with the provided number of evidence shares
+func FitsSquare(squareSize, evidenceShares uint64, txs []PackableTx) bool {
+	builder := types.NewSquareLayoutBuilder(squareSize, evidenceShares)
+	for _, tx := range txs {
+		if !builder.TryAdd(tx.Tx, tx.Messages) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewSquarePacker returns the SquarePacker with the provided name. The
+// FeePriorityPacker is used if no name is provided.
+func NewSquarePacker(name string) (SquarePacker, error) {
+	switch name {
+	case "", FeePriorityPackerName:
+		return NewFeePriorityPacker(BondDenom), nil
+	case MempoolOrderPackerName:
+		return MempoolOrderPacker{}, nil
+	default:
+		return nil, fmt.Errorf("unknown square packer %q", name)
+	}
+}
+
+// squarePackerFromOptions returns the SquarePacker selected in the app options
+func squarePackerFromOptions(appOpts servertypes.AppOptions) SquarePacker {
+	packer, err := NewSquarePacker(cast.ToString(appOpts.Get(FlagSquarePacker)))
+	if err != nil {
+		panic(err)
+	}
+	return packer
+}
+
+// SetSquarePacker sets the strategy used by PreprocessTxs to pack txs into the
+// square
+func (app *App) SetSquarePacker(packer SquarePacker) {
+	app.squarePacker = packer
+}
+
+// AddSquarePackerFlags adds the flag used to select the square packer to the
+// start command
+func AddSquarePackerFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(
+		FlagSquarePacker,
+		FeePriorityPackerName,
+		fmt.Sprintf("Strategy used to pack txs into the square when proposing a block (%s|%s)", FeePriorityPackerName, MempoolOrderPackerName),
+	)
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"crypto/sha256"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/tendermint/tendermint/pkg/consts"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// pendingTx is a transaction that passed the validation performed by
+// PreprocessTxs. wireMsg is nil for transactions that don't pay for messages.
+type pendingTx struct {
+	rawTx   []byte
+	authTx  signing.Tx
+	wireMsg sdk.Msg
+}
+
+// blockData is the block data that PreprocessTxs would return for a single
+// square size
+type blockData struct {
+	txs    [][]byte
+	msgs   []*core.Message
+	layout types.SquareLayout
+	// included is the number of pending txs that made it into the block. A tx
+	// is left out if it doesn't commit to the square size or doesn't fit.
+	included int
+}
+
+// selectSquareSize builds the block data for each of the candidate square
+// sizes and returns the block data of the smallest square that fits every
+// pending tx. If no square fits every tx, the block data that includes the
+// most txs is returned, preferring the smaller square on a tie.
+//
+// celestia-core derives the width of the square from the number of shares
+// used by the block data, so the messages of a block that uses fewer shares
+// than the selected square are followed by tail padding. Otherwise, the
+// included messages would commit to a different square size than the one used
+// for the block. A block without messages is left as is.
+func (app *App) selectSquareSize(squareSizes []uint64, evidenceShares uint64, pending []pendingTx) blockData {
+	candidates := make([]uint64, len(squareSizes))
+	copy(candidates, squareSizes)
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	var best blockData
+	for i, squareSize := range candidates {
+		data := app.buildBlockData(squareSize, evidenceShares, pending)
+		if i == 0 || data.included > best.included {
+			best = data
+		}
+		if data.included == len(pending) {
+			break
+		}
+	}
+
+	if len(best.msgs) == 0 {
+		best.layout = types.NewSquareLayout(squareWidth(best.layout.UsedShares), best.layout.ReservedShares, nil)
+		return best
+	}
+	best.msgs = append(best.msgs, best.layout.TailPadding()...)
+	return best
+}
+
+// buildBlockData uses the commitments and signatures for the provided square
+// size to turn the pending txs into block data. Wire txs that are missing a
+// commitment for the square size are left out, and the square packer decides
+// which of the remaining txs are included. The messages are laid out after
+// the provided number of evidence shares, which core writes before them.
+func (app *App) buildBlockData(squareSize, evidenceShares uint64, pending []pendingTx) blockData {
+	var candidates []PackableTx
+	for _, ptx := range pending {
+		signers := make([]string, len(ptx.authTx.GetSigners()))
+		for i, signer := range ptx.authTx.GetSigners() {
+			signers[i] = signer.String()
+		}
+
+		if ptx.wireMsg == nil {
+			candidates = append(candidates, PackableTx{Tx: ptx.rawTx, Fee: ptx.authTx.GetFee(), Signers: signers})
+			continue
+		}
+
+		// parse the wire message into the core messages and the
+		// MsgPayForMessages that pay for them
+		coreMsgs, unsignedPFMs, sig, err := types.ProcessWireMsg(ptx.wireMsg, squareSize)
+		if err != nil {
+			continue
+		}
+
+		// create a single signed tx containing each PayForMessage using the fees,
+		// gas limit, and sequence from the original transaction, along with the
+		// appropriate signature.
+		signedTx, err := types.BuildPayForMessageTxFromWireTx(ptx.authTx, app.txConfig.NewTxBuilder(), sig, unsignedPFMs...)
+		if err != nil {
+			app.Logger().Error("failure to create signed PayForMessage", err)
+			continue
+		}
+
+		rawProcessedTx, err := app.txConfig.TxEncoder()(signedTx)
+		if err != nil {
+			continue
+		}
+
+		parentHash := sha256.Sum256(ptx.rawTx)
+		wrappedTx, err := coretypes.WrapMalleatedTx(parentHash[:], rawProcessedTx)
+		if err != nil {
+			app.Logger().Error("failure to wrap child transaction with parent hash", "Error:", err)
+		}
+
+		candidates = append(candidates, PackableTx{Tx: wrappedTx, Messages: coreMsgs, Fee: ptx.authTx.GetFee(), Signers: signers})
+	}
+
+	// leave out any of the packed txs that don't fit, along with the later
+	// txs of their signers, in case the packer misbehaves
+	builder := types.NewSquareLayoutBuilder(squareSize, evidenceShares)
+	skippedSigners := make(map[string]bool)
+	var included []PackableTx
+	for _, tx := range app.squarePacker.Pack(squareSize, evidenceShares, candidates) {
+		if hasSkippedSigner(tx, skippedSigners) || !builder.TryAdd(tx.Tx, tx.Messages) {
+			for _, signer := range tx.Signers {
+				skippedSigners[signer] = true
+			}
+			continue
+		}
+		included = append(included, tx)
+	}
+
+	data := blockData{included: len(included)}
+	var msgs []*core.Message
+	for _, tx := range included {
+		data.txs = append(data.txs, tx.Tx)
+		msgs = append(msgs, tx.Messages...)
+	}
+
+	// order the messages as they are laid out in the square, and add the
+	// padding that aligns them
+	layout := builder.Layout()
+	orderedMsgs := make([]*core.Message, len(layout.Messages))
+	for i, msgLayout := range layout.Messages {
+		orderedMsgs[i] = msgs[msgLayout.Index]
+	}
+	data.layout = types.NewSquareLayout(squareSize, layout.ReservedShares, orderedMsgs)
+	data.msgs = data.layout.BlockMessages(orderedMsgs)
+
+	return data
+}
+
+// hasSkippedSigner returns true if one of the signers of the tx is skipped
+func hasSkippedSigner(tx PackableTx, skippedSigners map[string]bool) bool {
+	for _, signer := range tx.Signers {
+		if skippedSigners[signer] {
+			return true
+		}
+	}
+	return false
+}
+
+// squareWidth returns the width of the smallest square with a power of two
+// width that holds the provided number of shares, in the same way that core
+// computes it
+func squareWidth(shares uint64) uint64 {
+	width := uint64(consts.MinSquareSize)
+	for width*width < shares {
+		width *= 2
+	}
+	return width
+}
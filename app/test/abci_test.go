@@ -2,11 +2,14 @@ package app_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"math"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/testutil"
 	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/celestiaorg/nmt"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +18,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/pkg/consts"
 	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
 )
 
 func TestPreprocessTxs(t *testing.T) {
@@ -24,6 +28,7 @@ func TestPreprocessTxs(t *testing.T) {
 	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
 
 	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
 
 	type test struct {
 		input            abci.RequestPreprocessTxs
@@ -50,8 +55,8 @@ func TestPreprocessTxs(t *testing.T) {
 			},
 			expectedMessages: []*core.Message{
 				{
-					NamespaceId: secondNS,                                           // the second message should be first
-					Data:        append([]byte{2}, bytes.Repeat([]byte{0}, 255)...), // check that the message is padded
+					NamespaceId: secondNS,      // the second message should be first
+					Data:        secondMessage, // check that the message is not padded
 				},
 				{
 					NamespaceId: firstNS,
@@ -73,16 +78,289 @@ func TestPreprocessTxs(t *testing.T) {
 	}
 }
 
-func generateRawTx(t *testing.T, txConfig client.TxConfig, ns, message []byte, signer *types.KeyringSigner) (rawTx []byte) {
-	// create a msg
-	msg := generateSignedWirePayForMessage(t, consts.MaxSquareSize, ns, message, signer)
+func TestPreprocessTxsFirstBlock(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	// the genesis state is only committed with the first block
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	rawTx := generateRawTx(t, encCfg.TxConfig, []byte{1, 1, 1, 1, 1, 1, 1, 1}, []byte{1}, signer)
+
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{rawTx}})
+	assert.Empty(t, res.Txs)
+	assert.Empty(t, res.Messages.MessagesList)
+
+	testApp.Commit()
+	res = testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{rawTx}})
+	assert.Len(t, res.Txs, 1)
+}
+
+func TestPreprocessTxsNamespaceWriters(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+
+	ownedNS := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	openNS := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+
+	// register the first namespace to a different account
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	ctx := testApp.NewUncachedContext(true, core.Header{})
+	testApp.PaymentKeeper.SetNamespace(ctx, types.Namespace{NamespaceId: ownedNS, Owner: owner.String()})
+
+	ownedRawTx := generateRawTx(t, encCfg.TxConfig, ownedNS, []byte{1}, signer)
+	openRawTx := generateRawTx(t, encCfg.TxConfig, openNS, []byte{2}, signer)
+
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{ownedRawTx, openRawTx}})
+	require.Len(t, res.Messages.MessagesList, 1)
+	assert.Equal(t, openNS, res.Messages.MessagesList[0].NamespaceId)
+	assert.Equal(t, 1, len(res.Txs))
+
+	// once the signer is added as a writer, both messages are included
+	testApp.PaymentKeeper.SetNamespace(ctx, types.Namespace{
+		NamespaceId: ownedNS,
+		Owner:       owner.String(),
+		Writers:     []string{info.GetAddress().String()},
+	})
+
+	res = testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{ownedRawTx, openRawTx}})
+	assert.Len(t, res.Messages.MessagesList, 2)
+	assert.Equal(t, 2, len(res.Txs))
+}
+
+func TestPreprocessTxsMultipleMessages(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+
+	namespaces := [][]byte{{3, 3, 3, 3, 3, 3, 3, 3}, {1, 1, 1, 1, 1, 1, 1, 1}}
+	messages := [][]byte{bytes.Repeat([]byte{3}, 300), {1}}
+
+	msg, err := types.NewWirePayForMessages(namespaces, messages, testSquareSizes...)
+	require.NoError(t, err)
+	err = msg.SignShareCommitments(signer, types.SetGasLimit(10000))
+	require.NoError(t, err)
 
 	builder := signer.NewTxBuilder()
+	builder.SetGasLimit(10000)
+	tx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+	rawTx, err := encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{rawTx}})
+
+	// each message is laid out separately, sorted by namespace
+	assert.Equal(t, []*core.Message{
+		{NamespaceId: namespaces[1], Data: messages[1]},
+		{NamespaceId: namespaces[0], Data: messages[0]},
+	}, paidMessages(res))
+
+	// a single malleated tx pays for both messages
+	require.Len(t, res.Txs, 1)
+	_, childTx, isMalleated := coretypes.UnwrapMalleatedTx(res.Txs[0])
+	require.True(t, isMalleated)
+	decoded, err := encCfg.TxConfig.TxDecoder()(childTx)
+	require.NoError(t, err)
+	require.Len(t, decoded.GetMsgs(), 2)
+	for i, sdkMsg := range decoded.GetMsgs() {
+		pfm, ok := sdkMsg.(*types.MsgPayForMessage)
+		require.True(t, ok)
+		assert.Equal(t, namespaces[i], pfm.MessageNamespaceId)
+	}
+}
+
+func TestPreprocessTxsSquareSize(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+
+	type test struct {
+		name               string
+		txs                [][]byte
+		expectedSquareSize uint64
+		expectedTxs        int
+	}
 
+	tests := []test{
+		{
+			name:               "single small message",
+			txs:                [][]byte{generateRawTxForSquareSizes(t, encCfg.TxConfig, ns, []byte{1}, signer, testSquareSizes...)},
+			expectedSquareSize: 2,
+			expectedTxs:        1,
+		},
+		{
+			// the message fits in a square of size 4, but not together with the
+			// tx paying for it
+			name: "larger message",
+			txs: [][]byte{generateRawTxForSquareSizes(
+				t, encCfg.TxConfig, ns, bytes.Repeat([]byte{1}, 15*consts.MsgShareSize-2), signer, 4, 8, 16,
+			)},
+			expectedSquareSize: 8,
+			expectedTxs:        1,
+		},
+		{
+			// both messages fit in a square of size 2, but the smallest size
+			// that both of them commit to is 8, so the square is padded
+			name: "pad the square to a size that every message commits to",
+			txs: [][]byte{
+				generateRawTxForSquareSizes(t, encCfg.TxConfig, ns, []byte{1}, signer, testSquareSizes...),
+				generateRawTxForSquareSizes(t, encCfg.TxConfig, ns, []byte{2}, signer, 8, 16),
+			},
+			expectedSquareSize: 8,
+			expectedTxs:        2,
+		},
+		{
+			name: "pad the square to the only committed size",
+			txs: [][]byte{
+				generateRawTxForSquareSizes(t, encCfg.TxConfig, ns, []byte{1}, signer, 16),
+			},
+			expectedSquareSize: 16,
+			expectedTxs:        1,
+		},
+		{
+			name: "no square size fits the commitments",
+			txs: [][]byte{
+				generateRawTxForSquareSizes(t, encCfg.TxConfig, ns, []byte{1}, signer, 256),
+			},
+			expectedSquareSize: 1,
+			expectedTxs:        0,
+		},
+	}
+
+	for _, tt := range tests {
+		res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: tt.txs})
+		assert.Equal(t, tt.expectedSquareSize, testApp.SquareSize(), tt.name)
+		assert.Equal(t, tt.expectedTxs, len(res.Txs), tt.name)
+
+		// core derives the same square size from the block data, and the
+		// tail padding that makes it do so is valid
+		assert.Equal(t, tt.expectedSquareSize, computeSquareSize(t, res), tt.name)
+		assert.NoError(t, testApp.ValidateBlockData(&core.Data{Txs: res.Txs, Messages: *res.Messages}), tt.name)
+
+		// the messages are returned in the order of the square layout, and
+		// core writes their shares where the layout places them
+		layout := testApp.SquareLayout()
+		assert.True(t, layout.Fits(), tt.name)
+		msgs := paidMessages(res)
+		require.Len(t, layout.Messages, len(msgs), tt.name)
+		shares := computeShares(t, res)
+		for i, msgLayout := range layout.Messages {
+			assert.Equal(t, i, msgLayout.Index, tt.name)
+			assert.Equal(t, msgs[i].NamespaceId, msgLayout.NamespaceID, tt.name)
+			assert.Equal(t,
+				types.MessageShares(msgs[i].NamespaceId, msgs[i].Data),
+				shares[msgLayout.Start:msgLayout.Start+msgLayout.Shares],
+				tt.name,
+			)
+		}
+	}
+}
+
+func TestPreprocessTxsMessageAlignment(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+
+	// the first message uses 4 shares, so it is aligned to the start of a row
+	// of a square of size 4 after the shares of the txs
+	namespaces := [][]byte{{1, 1, 1, 1, 1, 1, 1, 1}, {2, 2, 2, 2, 2, 2, 2, 2}}
+	messages := [][]byte{bytes.Repeat([]byte{1}, 4*consts.MsgShareSize-2), {2}}
+	txs := [][]byte{
+		generateRawTxForSquareSizes(t, encCfg.TxConfig, namespaces[0], messages[0], signer, 4),
+		generateRawTxForSquareSizes(t, encCfg.TxConfig, namespaces[1], messages[1], signer, 4),
+	}
+
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: txs})
+	require.Len(t, res.Txs, 2)
+	assert.Equal(t, uint64(4), computeSquareSize(t, res))
+
+	// the padding is written as empty messages in the namespace of the
+	// aligned message
+	layout := testApp.SquareLayout()
+	require.Len(t, layout.Messages, 2)
+	assert.Equal(t, uint64(4), layout.Messages[0].Start)
+	require.Less(t, layout.ReservedShares, uint64(4))
+	assert.Equal(t, 4-layout.ReservedShares, layout.PaddingShares)
+	var expected []*core.Message
+	for i := uint64(0); i < layout.PaddingShares; i++ {
+		expected = append(expected, &core.Message{NamespaceId: namespaces[0]})
+	}
+	expected = append(expected,
+		&core.Message{NamespaceId: namespaces[0], Data: messages[0]},
+		&core.Message{NamespaceId: namespaces[1], Data: messages[1]},
+	)
+	assert.Equal(t, expected, res.Messages.MessagesList)
+
+	// the share commitment of the aligned message is created over the root of
+	// its row
+	shares := computeShares(t, res)
+	tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(types.NamespaceIDSize))
+	for _, share := range shares[4:8] {
+		require.NoError(t, tree.Push(append(append([]byte{}, namespaces[0]...), share...)))
+	}
+	subtrees, err := types.CommitmentSubtrees(4, namespaces[0], messages[0])
+	require.NoError(t, err)
+	require.Len(t, subtrees, 1)
+	assert.Equal(t, tree.Root(), subtrees[0].Root)
+
+	// the block data is valid, padding included
+	assert.NoError(t, testApp.ValidateBlockData(&core.Data{Txs: res.Txs, Messages: *res.Messages}))
+}
+
+func generateRawTx(t *testing.T, txConfig client.TxConfig, ns, message []byte, signer *types.KeyringSigner) (rawTx []byte) {
+	return generateRawTxForSquareSizes(t, txConfig, ns, message, signer, testSquareSizes...)
+}
+
+func generateRawTxForSquareSizes(
+	t *testing.T,
+	txConfig client.TxConfig,
+	ns, message []byte,
+	signer *types.KeyringSigner,
+	sizes ...uint64,
+) (rawTx []byte) {
 	coin := sdk.Coin{
 		Denom:  "token",
 		Amount: sdk.NewInt(1000),
 	}
+	return generateRawTxWithFee(t, txConfig, ns, message, signer, coin, sizes...)
+}
+
+func generateRawTxWithFee(
+	t *testing.T,
+	txConfig client.TxConfig,
+	ns, message []byte,
+	signer *types.KeyringSigner,
+	coin sdk.Coin,
+	sizes ...uint64,
+) (rawTx []byte) {
+	// create a msg
+	msg, err := types.NewWirePayForMessage(ns, message, sizes...)
+	require.NoError(t, err)
+	err = msg.SignShareCommitments(signer)
+	require.NoError(t, err)
+
+	builder := signer.NewTxBuilder()
 
 	builder.SetFeeAmount(sdk.NewCoins(coin))
 	builder.SetGasLimit(10000)
@@ -98,20 +376,66 @@ func generateRawTx(t *testing.T, txConfig client.TxConfig, ns, message []byte, s
 	return rawTx
 }
 
-func generateSignedWirePayForMessage(t *testing.T, k uint64, ns, message []byte, signer *types.KeyringSigner) *types.MsgWirePayForMessage {
-	msg, err := types.NewWirePayForMessage(ns, message, k)
-	if err != nil {
-		t.Error(err)
-	}
+// computeSquareSize returns the width of the square that core computes for the
+// block data
+func computeSquareSize(t *testing.T, res abci.ResponsePreprocessTxs) uint64 {
+	return uint64(math.Sqrt(float64(len(computeShares(t, res)))))
+}
 
-	err = msg.SignShareCommitments(signer)
-	if err != nil {
-		t.Error(err)
-	}
+// computeShares returns the shares that core computes for the block data
+func computeShares(t *testing.T, res abci.ResponsePreprocessTxs) [][]byte {
+	data, err := coretypes.DataFromProto(&core.Data{Txs: res.Txs, Messages: *res.Messages})
+	require.NoError(t, err)
+	shares, _ := data.ComputeShares()
+	return shares.RawShares()
+}
 
-	return msg
+// paidMessages returns the messages of the block data without the empty
+// messages used as padding
+func paidMessages(res abci.ResponsePreprocessTxs) []*core.Message {
+	var msgs []*core.Message
+	for _, msg := range res.Messages.MessagesList {
+		if len(msg.Data) != 0 {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
 }
 
 const (
 	testAccName = "test-account"
 )
+
+// testSquareSizes are the square sizes that the test messages commit to
+var testSquareSizes = []uint64{2, 4, 8, 16, 32, 64, consts.MaxSquareSize}
+
+func TestPreprocessTxsMessageFee(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+	ctx := testApp.NewUncachedContext(true, core.Header{})
+	balance := testApp.BankKeeper.GetBalance(ctx, info.GetAddress(), app.BondDenom).Amount
+
+	// the signer can afford the message fee of a single share, but not of two
+	params := testApp.PaymentKeeper.GetParams(ctx)
+	params.FeePerShare = balance.QuoRaw(2).AddRaw(1)
+	testApp.PaymentKeeper.SetParams(ctx, params)
+
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	smallRawTx := generateRawTx(t, encCfg.TxConfig, ns, []byte{1}, signer)
+	largeRawTx := generateRawTx(t, encCfg.TxConfig, ns, bytes.Repeat([]byte{1}, 2*types.ShareSize), signer)
+
+	// the large message costs more than the balance of the signer
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{largeRawTx}})
+	assert.Empty(t, res.Txs)
+	assert.Empty(t, paidMessages(res))
+
+	// the message fee of the second tx is only affordable on its own
+	res = testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{smallRawTx, smallRawTx}})
+	assert.Len(t, res.Txs, 1)
+	assert.Len(t, paidMessages(res), 1)
+}
@@ -0,0 +1,274 @@
+package app_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	paymentante "github.com/celestiaorg/celestia-app/x/payment/ante"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestCommitmentSignatureDecorator(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	options := []types.TxBuilderOption{
+		types.SetGasLimit(200000),
+		types.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("token", 1000))),
+	}
+
+	type test struct {
+		name      string
+		modify    func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage)
+		expectErr error
+	}
+
+	tests := []test{
+		{
+			name:   "valid signatures",
+			modify: func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage) {},
+		},
+		{
+			name: "invalid signature for a single square size",
+			modify: func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage) {
+				msg.MessageShareCommitment[1].Signature = msg.MessageShareCommitment[0].Signature
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "signed with the wrong sequence",
+			modify: func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage) {
+				signer.SetSequence(1)
+				require.NoError(t, msg.SignShareCommitments(signer, options...))
+				signer.SetSequence(0)
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "valid amino JSON signatures",
+			modify: func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage) {
+				signer.SetSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+				require.NoError(t, msg.SignShareCommitments(signer, options...))
+			},
+		},
+		{
+			name: "sign mode that doesn't match the signature",
+			modify: func(signer *types.KeyringSigner, msg *types.MsgWirePayForMessage) {
+				msg.MessageShareCommitment[0].SignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+			},
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		signer := testutil.GenerateKeyringSigner(t, testAccName)
+		signerAddr := signer.GetSignerInfo().GetAddress()
+		testApp := testutil.SetupTestApp(t, signerAddr)
+
+		// use the chain ID of the keyring signer
+		ctx := testApp.NewContext(false, core.Header{ChainID: "test-chain-1"})
+		signer.SetAccountNumber(testApp.AccountKeeper.GetAccount(ctx, signerAddr).GetAccountNumber())
+
+		anteHandler, err := app.NewAnteHandler(app.HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   testApp.AccountKeeper,
+				BankKeeper:      testApp.BankKeeper,
+				SignModeHandler: encCfg.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  testApp.FeeGrantKeeper,
+			},
+			PaymentKeeper: testApp.PaymentKeeper,
+			TxConfig:      encCfg.TxConfig,
+		})
+		require.NoError(t, err)
+
+		// the message is large enough to have a different commitment for each
+		// square size
+		msg, err := types.NewWirePayForMessage(ns, bytes.Repeat([]byte{1}, 2000), 4, 8)
+		require.NoError(t, err)
+		require.NoError(t, msg.SignShareCommitments(signer, options...))
+		tt.modify(signer, msg)
+
+		builder := signer.NewTxBuilder()
+		for _, option := range options {
+			builder = option(builder)
+		}
+		tx, err := signer.BuildSignedTx(builder, msg)
+		require.NoError(t, err)
+
+		_, err = anteHandler(ctx.WithIsCheckTx(true), tx, false)
+		if tt.expectErr == nil {
+			assert.NoError(t, err, tt.name)
+			continue
+		}
+		assert.ErrorIs(t, err, tt.expectErr, tt.name)
+	}
+}
+
+func TestMessageGasDecorator(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	ctx := testApp.NewContext(false, core.Header{})
+	params := testApp.PaymentKeeper.GetParams(ctx)
+
+	decorator := paymentante.NewMessageGasDecorator(testApp.PaymentKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	// gasUsed returns the gas consumed by the decorator for a wire message
+	gasUsed := func(size int, sizes ...uint64) uint64 {
+		msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, bytes.Repeat([]byte{1}, size), sizes...)
+		require.NoError(t, err)
+		msg.Signer = signerAddr.String()
+		builder := signer.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err = decorator.AnteHandle(gasCtx, builder.GetTx(), false, next)
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	// the params are read for every tx, so only the difference in gas is
+	// compared
+	base := gasUsed(100, 4)
+	assert.Equal(t, base+900*params.GasPerMessageByte, gasUsed(1000, 4))
+	assert.Equal(t, base+2*params.GasPerCommitment, gasUsed(100, 4, 8, 16))
+
+	// txs that don't pay for messages don't consume any gas
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := decorator.AnteHandle(gasCtx, signer.NewTxBuilder().GetTx(), false, next)
+	require.NoError(t, err)
+	assert.Zero(t, gasCtx.GasMeter().GasConsumed())
+
+	// the gas for a message size that overflows is rejected instead of
+	// wrapping around
+	builder := signer.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&types.MsgPayForMessage{
+		Signer:             signerAddr.String(),
+		MessageNamespaceId: []byte{1, 1, 1, 1, 1, 1, 1, 1},
+		MessageSize:        math.MaxUint64/params.GasPerMessageByte + 1,
+	}))
+	gasCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = decorator.AnteHandle(gasCtx, builder.GetTx(), false, next)
+	assert.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+}
+
+func TestMessageFeeDecorator(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	ctx := testApp.NewContext(false, core.Header{})
+
+	params := testApp.PaymentKeeper.GetParams(ctx)
+	params.FeePerShare = sdk.NewInt(1000)
+	params.BurnRatio = sdk.OneDec()
+	testApp.PaymentKeeper.SetParams(ctx, params)
+
+	decorator := paymentante.NewMessageFeeDecorator(testApp.PaymentKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	wireTx := func(messages ...[]byte) sdk.Tx {
+		namespaces := make([][]byte, len(messages))
+		for i := range messages {
+			namespaces[i] = []byte{1, 1, 1, 1, 1, 1, 1, 1}
+		}
+		msg, err := types.NewWirePayForMessages(namespaces, messages, 64)
+		require.NoError(t, err)
+		msg.Signer = signerAddr.String()
+		builder := signer.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		return builder.GetTx()
+	}
+	balance := func() int64 {
+		return testApp.BankKeeper.GetBalance(ctx, signerAddr, app.BondDenom).Amount.Int64()
+	}
+
+	// each message is charged for its own shares
+	before := balance()
+	_, err := decorator.AnteHandle(ctx, wireTx([]byte{1}, bytes.Repeat([]byte{2}, 2*types.ShareSize)), false, next)
+	require.NoError(t, err)
+	assert.Equal(t, before-4*1000, balance())
+
+	// txs that don't pay for messages aren't charged
+	before = balance()
+	_, err = decorator.AnteHandle(ctx, signer.NewTxBuilder().GetTx(), false, next)
+	require.NoError(t, err)
+	assert.Equal(t, before, balance())
+
+	// a signer that can't pay for the shares is rejected
+	_, err = decorator.AnteHandle(ctx, wireTx(bytes.Repeat([]byte{1}, 1000*types.ShareSize)), false, next)
+	assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestAminoJSONWireTxRoundTrip(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signer.SetSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+
+	// use the chain ID of the keyring signer
+	ctx := testApp.NewContext(false, core.Header{ChainID: "test-chain-1"})
+	accNum := testApp.AccountKeeper.GetAccount(ctx, signerAddr).GetAccountNumber()
+	signer.SetAccountNumber(accNum)
+
+	msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, bytes.Repeat([]byte{1}, 300), testSquareSizes...)
+	require.NoError(t, err)
+	require.NoError(t, msg.SignShareCommitments(signer, types.SetGasLimit(200000)))
+
+	// sign an amino StdTx, and encode it to JSON using the app's amino codec
+	stdTxConfig := legacytx.StdTxConfig{Cdc: encCfg.Amino}
+	stdBuilder := types.SetGasLimit(200000)(stdTxConfig.NewTxBuilder())
+	signerData := authsigning.SignerData{ChainID: "test-chain-1", AccountNumber: accNum}
+	stdTx, err := types.SignTx(signer, stdTxConfig, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, stdBuilder, msg)
+	require.NoError(t, err)
+	txJSON, err := stdTxConfig.TxJSONEncoder()(stdTx)
+	require.NoError(t, err)
+
+	// decode it and convert it to a protobuf tx
+	decoded, err := stdTxConfig.TxJSONDecoder()(txJSON)
+	require.NoError(t, err)
+	decodedStdTx, ok := decoded.(legacytx.StdTx)
+	require.True(t, ok)
+	require.Equal(t, msg, decodedStdTx.GetMsgs()[0])
+	sigs, err := decodedStdTx.GetSignaturesV2()
+	require.NoError(t, err)
+
+	builder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(decodedStdTx.GetMsgs()...))
+	builder.SetGasLimit(decodedStdTx.GetGas())
+	builder.SetFeeAmount(decodedStdTx.GetFee())
+	require.NoError(t, builder.SetSignatures(sigs...))
+	rawTx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	tx, err := encCfg.TxConfig.TxDecoder()(rawTx)
+	require.NoError(t, err)
+
+	// the signatures of the tx and of the commitments are verified by the
+	// ante handler
+	anteHandler, err := app.NewAnteHandler(app.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   testApp.AccountKeeper,
+			BankKeeper:      testApp.BankKeeper,
+			SignModeHandler: encCfg.TxConfig.SignModeHandler(),
+			FeegrantKeeper:  testApp.FeeGrantKeeper,
+		},
+		PaymentKeeper: testApp.PaymentKeeper,
+		TxConfig:      encCfg.TxConfig,
+	})
+	require.NoError(t, err)
+	_, err = anteHandler(ctx.WithIsCheckTx(true), tx, false)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDeliverMalleatedTxs(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	testApp.Commit()
+
+	ctx := testApp.NewContext(true, core.Header{})
+	signer.SetAccountNumber(testApp.AccountKeeper.GetAccount(ctx, signerAddr).GetAccountNumber())
+
+	msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, bytes.Repeat([]byte{1}, 300), testSquareSizes...)
+	require.NoError(t, err)
+	require.NoError(t, msg.SignShareCommitments(signer, types.SetGasLimit(200000)))
+	builder := types.SetGasLimit(200000)(signer.NewTxBuilder())
+	tx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+	wireTx, err := signer.EncodeTx(tx)
+	require.NoError(t, err)
+
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{wireTx}})
+	require.Len(t, res.Txs, 1)
+	malleatedTx := res.Txs[0]
+	_, childTx, isMalleated := coretypes.UnwrapMalleatedTx(malleatedTx)
+	require.True(t, isMalleated)
+
+	// malleated txs are created by the block producer and can't be submitted
+	// to the mempool
+	checkRes := testApp.CheckTx(abci.RequestCheckTx{Tx: malleatedTx})
+	assert.Equal(t, types.ErrMalformedMalleatedTx.ABCICode(), checkRes.Code, checkRes.Log)
+
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: core.Header{Height: 2, ChainID: "test-chain-1"}})
+
+	type test struct {
+		name      string
+		tx        []byte
+		expectErr *sdkerrors.Error
+	}
+
+	tests := []test{
+		{
+			name:      "wire message that was not malleated",
+			tx:        wireTx,
+			expectErr: types.ErrUnmalleatedWireMsg,
+		},
+		{
+			name:      "MsgPayForMessage that was not malleated",
+			tx:        childTx,
+			expectErr: types.ErrPayForMessageNotMalleated,
+		},
+		{
+			name: "malleated tx",
+			tx:   malleatedTx,
+		},
+		{
+			name:      "malleated tx with the parent hash of a tx in the same block",
+			tx:        malleatedTx,
+			expectErr: types.ErrMalformedMalleatedTx,
+		},
+	}
+
+	for _, tt := range tests {
+		deliverRes := testApp.DeliverTx(abci.RequestDeliverTx{Tx: tt.tx})
+		if tt.expectErr == nil {
+			assert.Equal(t, abci.CodeTypeOK, deliverRes.Code, tt.name, deliverRes.Log)
+			continue
+		}
+		assert.Equal(t, tt.expectErr.Codespace(), deliverRes.Codespace, tt.name)
+		assert.Equal(t, tt.expectErr.ABCICode(), deliverRes.Code, tt.name, deliverRes.Log)
+	}
+}
+
+func TestDeliverAminoJSONMalleatedTx(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signer.SetSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	testApp.Commit()
+
+	ctx := testApp.NewContext(true, core.Header{})
+	signer.SetAccountNumber(testApp.AccountKeeper.GetAccount(ctx, signerAddr).GetAccountNumber())
+
+	msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, bytes.Repeat([]byte{1}, 300), testSquareSizes...)
+	require.NoError(t, err)
+	require.NoError(t, msg.SignShareCommitments(signer, types.SetGasLimit(200000)))
+	for _, commit := range msg.MessageShareCommitment {
+		require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, commit.SignMode)
+	}
+	builder := types.SetGasLimit(200000)(signer.NewTxBuilder())
+	tx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+	wireTx, err := signer.EncodeTx(tx)
+	require.NoError(t, err)
+
+	// the malleated tx is signed using the sign mode of the commitment
+	// signature
+	res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{wireTx}})
+	require.Len(t, res.Txs, 1)
+
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: core.Header{Height: 2, ChainID: "test-chain-1"}})
+	deliverRes := testApp.DeliverTx(abci.RequestDeliverTx{Tx: res.Txs[0]})
+	assert.Equal(t, abci.CodeTypeOK, deliverRes.Code, deliverRes.Log)
+}
@@ -0,0 +1,158 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/pkg/consts"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestSquarePackers(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+	highFeeSigner := testutil.GenerateKeyringSigner(t, "high-fee")
+	midFeeSigner := testutil.GenerateKeyringSigner(t, "mid-fee")
+	sendSigner := testutil.GenerateKeyringSigner(t, "send")
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	// each message uses 6 shares and is aligned to a row of a square of size
+	// 4, so only a single message fits in the square
+	msgSize := 6*consts.MsgShareSize - 2
+	lowFeeMsg := bytes.Repeat([]byte{1}, msgSize)
+	highFeeMsg := bytes.Repeat([]byte{2}, msgSize)
+	midFeeMsg := bytes.Repeat([]byte{3}, msgSize)
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+
+	lowFeeTx := generateRawTxWithFee(t, encCfg.TxConfig, ns, lowFeeMsg, signer, sdk.NewInt64Coin(app.BondDenom, 100), 4)
+	highFeeTx := generateRawTxWithFee(t, encCfg.TxConfig, ns, highFeeMsg, highFeeSigner, sdk.NewInt64Coin(app.BondDenom, 300), 4)
+	midFeeTx := generateRawTxWithFee(t, encCfg.TxConfig, ns, midFeeMsg, midFeeSigner, sdk.NewInt64Coin(app.BondDenom, 200), 4)
+	sendTx := generateRawSendTx(t, encCfg, sendSigner)
+
+	// the next tx of the signer of the low fee tx pays the highest fee for a
+	// message that fits next to any other message, but it can't be included
+	// without the low fee tx
+	smallMsg := []byte{4}
+	signer.SetSequence(1)
+	nextSequenceTx := generateRawTxWithFee(t, encCfg.TxConfig, ns, smallMsg, signer, sdk.NewInt64Coin(app.BondDenom, 1000), 4)
+	signer.SetSequence(0)
+
+	type test struct {
+		name             string
+		packer           app.SquarePacker
+		txs              [][]byte
+		expectedMessages [][]byte
+		expectedTxs      int
+	}
+
+	tests := []test{
+		{
+			name:             "fee priority includes the highest fee message",
+			packer:           app.NewFeePriorityPacker(app.BondDenom),
+			txs:              [][]byte{lowFeeTx, highFeeTx, midFeeTx},
+			expectedMessages: [][]byte{highFeeMsg},
+			expectedTxs:      1,
+		},
+		{
+			name:             "fee priority skips messages that don't fit",
+			packer:           app.NewFeePriorityPacker(app.BondDenom),
+			txs:              [][]byte{lowFeeTx, midFeeTx, sendTx},
+			expectedMessages: [][]byte{midFeeMsg},
+			expectedTxs:      2,
+		},
+		{
+			name:             "fee priority skips the later txs of a skipped signer",
+			packer:           app.NewFeePriorityPacker(app.BondDenom),
+			txs:              [][]byte{lowFeeTx, highFeeTx, nextSequenceTx},
+			expectedMessages: [][]byte{highFeeMsg},
+			expectedTxs:      1,
+		},
+		{
+			name:             "fee priority includes the later txs of an included signer",
+			packer:           app.NewFeePriorityPacker(app.BondDenom),
+			txs:              [][]byte{lowFeeTx, nextSequenceTx},
+			expectedMessages: [][]byte{lowFeeMsg, smallMsg},
+			expectedTxs:      2,
+		},
+		{
+			name:             "mempool order includes the first message",
+			packer:           app.MempoolOrderPacker{},
+			txs:              [][]byte{lowFeeTx, highFeeTx, midFeeTx},
+			expectedMessages: [][]byte{lowFeeMsg},
+			expectedTxs:      1,
+		},
+		{
+			name:             "mempool order stops at the first tx that doesn't fit",
+			packer:           app.MempoolOrderPacker{},
+			txs:              [][]byte{lowFeeTx, midFeeTx, sendTx},
+			expectedMessages: [][]byte{lowFeeMsg},
+			expectedTxs:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		testApp := testutil.SetupTestApp(t, info.GetAddress())
+		testApp.Commit()
+		testApp.SetSquarePacker(tt.packer)
+
+		// only allow a square size of 4 to create contention
+		ctx := testApp.NewUncachedContext(true, core.Header{})
+		params := types.DefaultParams()
+		params.SquareSizes = []uint64{4}
+		testApp.PaymentKeeper.SetParams(ctx, params)
+
+		// the other signers need funds to pay for their txs
+		for _, s := range []*types.KeyringSigner{highFeeSigner, midFeeSigner, sendSigner} {
+			funds := sdk.NewCoins(sdk.NewInt64Coin("token", 10000), sdk.NewInt64Coin(app.BondDenom, 10000))
+			err := testApp.BankKeeper.SendCoins(ctx, info.GetAddress(), s.GetSignerInfo().GetAddress(), funds)
+			require.NoError(t, err)
+		}
+
+		res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: tt.txs})
+		assert.Equal(t, uint64(4), testApp.SquareSize(), tt.name)
+		assert.Len(t, res.Txs, tt.expectedTxs, tt.name)
+		msgs := paidMessages(res)
+		require.Len(t, msgs, len(tt.expectedMessages), tt.name)
+		for i, msg := range tt.expectedMessages {
+			assert.Equal(t, msg, msgs[i].Data, tt.name)
+		}
+	}
+}
+
+func TestNewSquarePacker(t *testing.T) {
+	packer, err := app.NewSquarePacker("")
+	require.NoError(t, err)
+	assert.Equal(t, app.NewFeePriorityPacker(app.BondDenom), packer)
+
+	packer, err = app.NewSquarePacker(app.MempoolOrderPackerName)
+	require.NoError(t, err)
+	assert.Equal(t, app.MempoolOrderPacker{}, packer)
+
+	_, err = app.NewSquarePacker("unknown")
+	assert.Error(t, err)
+}
+
+func generateRawSendTx(t *testing.T, encCfg cosmoscmd.EncodingConfig, signer *types.KeyringSigner) []byte {
+	from := signer.GetSignerInfo().GetAddress()
+	to := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+
+	builder := signer.NewTxBuilder()
+	builder.SetGasLimit(10000)
+	tx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+
+	rawTx, err := encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return rawTx
+}
@@ -0,0 +1,174 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestValidateBlockData(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	info := signer.GetSignerInfo()
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+
+	testApp := testutil.SetupTestApp(t, info.GetAddress())
+	testApp.Commit()
+
+	firstNS := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	secondNS := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+	firstRawTx := generateRawTx(t, encCfg.TxConfig, firstNS, bytes.Repeat([]byte{1}, 300), signer)
+	secondRawTx := generateRawTx(t, encCfg.TxConfig, secondNS, []byte{2}, signer)
+	sendTx := generateRawSendTx(t, encCfg, signer)
+
+	// proposedData returns the block data proposed for the txs
+	proposedData := func() *core.Data {
+		res := testApp.PreprocessTxs(abci.RequestPreprocessTxs{Txs: [][]byte{firstRawTx, secondRawTx, sendTx}})
+		require.Len(t, res.Txs, 3)
+		require.Len(t, res.Messages.MessagesList, 2)
+		return &core.Data{Txs: res.Txs, Messages: *res.Messages}
+	}
+
+	type test struct {
+		name      string
+		modify    func(data *core.Data)
+		expectErr error
+	}
+
+	tests := []test{
+		{
+			name:   "valid block data",
+			modify: func(data *core.Data) {},
+		},
+		{
+			name: "message without a paying tx",
+			modify: func(data *core.Data) {
+				data.Messages.MessagesList = append(data.Messages.MessagesList, &core.Message{
+					NamespaceId: []byte{3, 3, 3, 3, 3, 3, 3, 3},
+					Data:        []byte{3},
+				})
+			},
+			expectErr: types.ErrOrphanMessage,
+		},
+		{
+			name: "padding that isn't needed to align the message",
+			modify: func(data *core.Data) {
+				padding := []*core.Message{{NamespaceId: firstNS}, {NamespaceId: firstNS}}
+				data.Messages.MessagesList = append(padding, data.Messages.MessagesList...)
+			},
+			expectErr: types.ErrExcessPadding,
+		},
+		{
+			name: "padding after the last message that doesn't fill the square",
+			modify: func(data *core.Data) {
+				data.Messages.MessagesList = append(data.Messages.MessagesList, &core.Message{NamespaceId: secondNS})
+			},
+			expectErr: types.ErrExcessPadding,
+		},
+		{
+			name: "unaligned message",
+			modify: func(data *core.Data) {
+				padding := []*core.Message{{NamespaceId: firstNS}}
+				data.Messages.MessagesList = append(padding, data.Messages.MessagesList...)
+			},
+			expectErr: types.ErrUnalignedMessage,
+		},
+		{
+			name: "dropped message",
+			modify: func(data *core.Data) {
+				data.Messages.MessagesList = data.Messages.MessagesList[1:]
+			},
+			expectErr: types.ErrMissingMessage,
+		},
+		{
+			name: "modified message",
+			modify: func(data *core.Data) {
+				data.Messages.MessagesList[1].Data = []byte{3}
+			},
+			expectErr: types.ErrMissingMessage,
+		},
+		{
+			name: "unsorted messages",
+			modify: func(data *core.Data) {
+				msgs := data.Messages.MessagesList
+				msgs[0], msgs[1] = msgs[1], msgs[0]
+			},
+			expectErr: types.ErrUnsortedMessages,
+		},
+		{
+			name: "malleated tx with an invalid child tx",
+			modify: func(data *core.Data) {
+				wrappedTx, err := coretypes.WrapMalleatedTx(bytes.Repeat([]byte{1}, 32), []byte{1, 2, 3})
+				require.NoError(t, err)
+				data.Txs = append(data.Txs, wrappedTx)
+			},
+			expectErr: types.ErrMalformedMalleatedTx,
+		},
+		{
+			name: "malleated tx without a MsgPayForMessage",
+			modify: func(data *core.Data) {
+				wrappedTx, err := coretypes.WrapMalleatedTx(bytes.Repeat([]byte{1}, 32), sendTx)
+				require.NoError(t, err)
+				data.Txs = append(data.Txs, wrappedTx)
+			},
+			expectErr: types.ErrMalformedMalleatedTx,
+		},
+		{
+			name: "MsgPayForMessage that is not malleated",
+			modify: func(data *core.Data) {
+				_, childTx, isMalleated := coretypes.UnwrapMalleatedTx(data.Txs[0])
+				require.True(t, isMalleated)
+				data.Txs[0] = childTx
+			},
+			expectErr: types.ErrMalformedMalleatedTx,
+		},
+		{
+			name: "malleated tx with a parent hash that isn't a sha256 hash",
+			modify: func(data *core.Data) {
+				_, childTx, isMalleated := coretypes.UnwrapMalleatedTx(data.Txs[0])
+				require.True(t, isMalleated)
+				wrappedTx, err := coretypes.WrapMalleatedTx(bytes.Repeat([]byte{1}, 20), childTx)
+				require.NoError(t, err)
+				data.Txs[0] = wrappedTx
+			},
+			// the tx is not unwrapped, so the message it pays for is orphaned
+			expectErr: types.ErrOrphanMessage,
+		},
+		{
+			name: "padding without messages",
+			modify: func(data *core.Data) {
+				data.Txs = data.Txs[2:]
+				data.Messages.MessagesList = []*core.Message{{NamespaceId: secondNS}}
+			},
+			expectErr: types.ErrExcessPadding,
+		},
+		{
+			name: "duplicate malleated tx",
+			modify: func(data *core.Data) {
+				data.Txs = append(data.Txs, data.Txs[0])
+			},
+			expectErr: types.ErrMalformedMalleatedTx,
+		},
+	}
+
+	for _, tt := range tests {
+		data := proposedData()
+		tt.modify(data)
+
+		err := testApp.ValidateBlockData(data)
+		if tt.expectErr == nil {
+			assert.NoError(t, err, tt.name)
+			continue
+		}
+		assert.ErrorIs(t, err, tt.expectErr, tt.name)
+	}
+}
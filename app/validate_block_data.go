@@ -0,0 +1,207 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/pkg/consts"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// ValidateBlockData checks that the messages of the proposed block data are
+// exactly the messages paid for by the malleated MsgPayForMessage txs in the
+// same block. For each MsgPayForMessage, the share commitment is recomputed
+// over the included message at the square size of the block. Messages that
+// are not paid for, malleated txs that can't be decoded, and
+// MsgPayForMessages that were not malleated cause the block data to be
+// rejected. Empty messages that are not paid for are padding, which is only
+// accepted where it aligns the following message to its first subtree, or as
+// the tail padding that makes celestia-core derive the square size.
+//
+// The parent hash of a malleated tx must be a sha256 hash, and is only checked
+// to be unique within the block. A tx wrapped with a parent hash of any other
+// length is not a malleated tx, so the messages it pays for are orphaned. The
+// original wire tx is not part of the block data, so whether it exists can't
+// be checked from the block data alone.
+//
+// PreprocessTxs checks the block data that it proposes. This version of
+// celestia-core does not pass the messages of a block to the application, so
+// the txs of the blocks of other proposers are checked as they are delivered,
+// and their messages can't be checked.
+func (app *App) ValidateBlockData(data *core.Data) error {
+	squareSize, reservedShares, err := blockSquareSize(data)
+	if err != nil {
+		return err
+	}
+
+	msgs := data.Messages.MessagesList
+	for i := 1; i < len(msgs); i++ {
+		if bytes.Compare(msgs[i-1].NamespaceId, msgs[i].NamespaceId) > 0 {
+			return types.ErrUnsortedMessages
+		}
+	}
+
+	paid := make([]bool, len(msgs))
+	malleatedTxs := newMalleatedTxValidator(app.txConfig.TxDecoder())
+	for i, rawTx := range data.Txs {
+		parentHash, childTx, isMalleated := coretypes.UnwrapMalleatedTx(rawTx)
+		if !isMalleated {
+			// txs that can't be decoded are rejected when they are delivered
+			tx, err := app.txConfig.TxDecoder()(rawTx)
+			if err != nil {
+				continue
+			}
+			if hasPayForMessage(tx) {
+				return sdkerrors.Wrapf(types.ErrMalformedMalleatedTx, "tx %d contains a MsgPayForMessage but is not malleated", i)
+			}
+			continue
+		}
+
+		pfms, err := malleatedTxs.validate(parentHash, childTx)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "tx %d", i)
+		}
+		for _, pfm := range pfms {
+			index, err := findPaidMessage(msgs, paid, pfm, squareSize)
+			if err != nil {
+				return err
+			}
+			if index < 0 {
+				return sdkerrors.Wrapf(types.ErrMissingMessage, "tx %d, square size %d", i, squareSize)
+			}
+			paid[index] = true
+		}
+	}
+
+	// paddingStart is the share after the last paid message, where the
+	// padding that aligns the next paid message starts
+	cursor := reservedShares
+	paddingStart := cursor
+	for i, msg := range msgs {
+		shares := types.MessageSharesUsed(len(msg.Data))
+		if !paid[i] {
+			if len(msg.Data) != 0 {
+				return sdkerrors.Wrapf(types.ErrOrphanMessage, "message %d", i)
+			}
+			cursor += shares
+			continue
+		}
+
+		switch start := types.MessageStart(paddingStart, shares, squareSize); {
+		case types.MessageStart(cursor, shares, squareSize) != cursor:
+			return sdkerrors.Wrapf(types.ErrUnalignedMessage, "message %d starts at share %d", i, cursor)
+		case cursor != start:
+			return sdkerrors.Wrapf(types.ErrExcessPadding, "message %d starts at share %d instead of %d", i, cursor, start)
+		}
+		cursor += shares
+		paddingStart = cursor
+	}
+
+	// the padding after the last paid message can only be the tail padding
+	// that PreprocessTxs adds for the square size
+	tailPadding := types.SquareLayout{SquareSize: squareSize, UsedShares: paddingStart}.TailPadding()
+	if cursor != paddingStart && (paddingStart == reservedShares || cursor-paddingStart != uint64(len(tailPadding))) {
+		return sdkerrors.Wrapf(types.ErrExcessPadding, "%d shares of tail padding", cursor-paddingStart)
+	}
+
+	return nil
+}
+
+// malleatedTxValidator checks the malleated txs of a block one at a time,
+// keeping track of the parent hashes of the txs that were already checked
+type malleatedTxValidator struct {
+	txDecoder sdk.TxDecoder
+	parents   map[string]bool
+}
+
+func newMalleatedTxValidator(txDecoder sdk.TxDecoder) *malleatedTxValidator {
+	return &malleatedTxValidator{txDecoder: txDecoder, parents: make(map[string]bool)}
+}
+
+// validate checks the parent hash and the child tx of a malleated tx, and
+// returns the MsgPayForMessages of the child tx
+func (v *malleatedTxValidator) validate(parentHash, childTx []byte) ([]*types.MsgPayForMessage, error) {
+	// core only unwraps txs with a parent hash of tmhash.Size bytes, but
+	// the parent hash has to be a sha256 hash regardless
+	if len(parentHash) != sha256.Size {
+		return nil, sdkerrors.Wrapf(types.ErrMalformedMalleatedTx, "parent hash of %d bytes instead of %d", len(parentHash), sha256.Size)
+	}
+	if v.parents[string(parentHash)] {
+		return nil, sdkerrors.Wrap(types.ErrMalformedMalleatedTx, "same parent hash as another tx")
+	}
+	v.parents[string(parentHash)] = true
+
+	tx, err := v.txDecoder(childTx)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrMalformedMalleatedTx, err.Error())
+	}
+	if len(tx.GetMsgs()) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrMalformedMalleatedTx, "does not pay for any message")
+	}
+
+	pfms := make([]*types.MsgPayForMessage, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		pfm, ok := msg.(*types.MsgPayForMessage)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrMalformedMalleatedTx, "unexpected %s", sdk.MsgTypeURL(msg))
+		}
+		pfms[i] = pfm
+	}
+	return pfms, nil
+}
+
+// findPaidMessage returns the index of the first message that hasn't already
+// been paid for and that matches the share commitment of the
+// MsgPayForMessage, or -1 if there is no such message
+func findPaidMessage(msgs []*core.Message, paid []bool, pfm *types.MsgPayForMessage, squareSize uint64) (int, error) {
+	for i, msg := range msgs {
+		if paid[i] ||
+			!bytes.Equal(msg.NamespaceId, pfm.MessageNamespaceId) ||
+			uint64(len(msg.Data)) != pfm.MessageSize {
+			continue
+		}
+
+		commit, err := types.CreateCommitment(squareSize, msg.NamespaceId, msg.Data)
+		if err != nil {
+			return -1, err
+		}
+		if bytes.Equal(commit, pfm.MessageShareCommitment) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// blockSquareSize returns the width of the square that celestia-core computes
+// for the block data, along with the number of shares used by the txs,
+// intermediate state roots, and evidence
+func blockSquareSize(data *core.Data) (uint64, uint64, error) {
+	coreData, err := coretypes.DataFromProto(data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reserved := len(coreData.Txs.SplitIntoShares()) +
+		len(coreData.IntermediateStateRoots.SplitIntoShares()) +
+		len(coreData.Evidence.SplitIntoShares())
+	shares := reserved + len(coreData.Messages.SplitIntoShares())
+	if shares > consts.MaxShareCount {
+		return 0, 0, fmt.Errorf("block data uses %d shares, more than the max of %d", shares, consts.MaxShareCount)
+	}
+
+	return squareWidth(uint64(shares)), uint64(reserved), nil
+}
+
+func hasPayForMessage(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if sdk.MsgTypeURL(msg) == types.URLMsgPayforMessage {
+			return true
+		}
+	}
+	return false
+}
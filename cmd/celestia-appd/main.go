@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/celestiaorg/celestia-app/app"
+	paycli "github.com/celestiaorg/celestia-app/x/payment/client/cli"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -23,6 +24,13 @@ func main() {
 		appBuilder,
 		// this line is used by starport scaffolding # root/arguments
 	)
+
+	if startCmd, _, err := rootCmd.Find([]string{"start"}); err == nil {
+		app.AddSquarePackerFlags(startCmd)
+	}
+
+	rootCmd.AddCommand(paycli.CmdCommitment())
+
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
 	}
@@ -166,7 +166,11 @@ func generateKeyring(t *testing.T, accts ...string) keyring.Keyring {
 // GenerateKeyringSigner creates a types.KeyringSigner with keys generated for
 // the provided accounts
 func GenerateKeyringSigner(t *testing.T, acct string) *types.KeyringSigner {
-	kr := generateKeyring(t)
+	var accts []string
+	if acct != testAccName {
+		accts = append(accts, acct)
+	}
+	kr := generateKeyring(t, accts...)
 	return types.NewKeyringSigner(kr, acct, testChainID)
 }
 
@@ -0,0 +1,91 @@
+package ante
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper restricts the functionality of the account keeper used by the
+// payment ante decorators
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// CommitmentSignatureDecorator verifies the signatures included in every
+// MsgWirePayForMessage and MsgWirePayForMessages of a transaction. For each
+// signed square size, the transaction that the block producer would malleate
+// the wire message into is rebuilt, and its signature is checked against the
+// signer's pubkey, account number, and sequence. This keeps transactions that
+// can't be malleated into a valid transaction out of the mempool.
+//
+// CONTRACT: the decorator must run after the SetPubKeyDecorator and before the
+// IncrementSequenceDecorator.
+type CommitmentSignatureDecorator struct {
+	ak       AccountKeeper
+	txConfig sdkclient.TxConfig
+}
+
+func NewCommitmentSignatureDecorator(ak AccountKeeper, txConfig sdkclient.TxConfig) CommitmentSignatureDecorator {
+	return CommitmentSignatureDecorator{ak: ak, txConfig: txConfig}
+}
+
+// AnteHandle fullfills the sdk.AnteDecorator interface
+func (csd CommitmentSignatureDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// signatures are not provided when simulating
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		sizes := types.WireMsgSquareSizes(msg)
+		if len(sizes) == 0 {
+			continue
+		}
+
+		sigTx, ok := tx.(authsigning.Tx)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+		}
+
+		signer := msg.GetSigners()[0]
+		acc := csd.ak.GetAccount(ctx, signer)
+		if acc == nil || acc.GetPubKey() == nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set: %s", signer)
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+		}
+
+		for _, k := range sizes {
+			_, unsignedPFMs, sig, err := types.ProcessWireMsg(msg, k)
+			if err != nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			malleatedTx, err := types.BuildPayForMessageTxFromWireTx(sigTx, csd.txConfig.NewTxBuilder(), sig, unsignedPFMs...)
+			if err != nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			// the malleated transaction is signed using the sign mode included
+			// in the wire message
+			signBytes, err := csd.txConfig.SignModeHandler().GetSignBytes(sig.SignMode, signerData, malleatedTx)
+			if err != nil {
+				return ctx, err
+			}
+
+			if !acc.GetPubKey().VerifySignature(signBytes, sig.Signature) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid signature for square size %d", k)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
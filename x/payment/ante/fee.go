@@ -0,0 +1,36 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MessageFeeDecorator charges the signer of every msg that pays for messages
+// the fee for each share of the messages, which is set by the FeePerShare
+// param. Wire messages are charged in CheckTx, so that the message fees of the
+// txs in the mempool are deducted from the balance of their signers, and the
+// MsgPayForMessages they are malleated into are charged when they are
+// delivered. The fee is charged before the msgs are executed, like the tx fee.
+type MessageFeeDecorator struct {
+	k PaymentKeeper
+}
+
+func NewMessageFeeDecorator(k PaymentKeeper) MessageFeeDecorator {
+	return MessageFeeDecorator{k: k}
+}
+
+// AnteHandle fullfills the sdk.AnteDecorator interface
+func (mfd MessageFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		fee := mfd.k.MsgMessageFee(ctx, msg)
+		if fee.IsZero() {
+			continue
+		}
+		// the msgs that pay for messages have a single signer
+		signer := msg.GetSigners()[0]
+		if err := mfd.k.ChargeMessageFee(ctx, signer, fee); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "message fee %s", fee)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,58 @@
+package ante
+
+import (
+	"math/bits"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MessageGasDecorator consumes gas for every message paid for in a
+// transaction, proportional to the size of the message and to the number of
+// share commitments that are computed to verify it. The gas schedule is set by
+// the GasPerMessageByte and GasPerCommitment params.
+type MessageGasDecorator struct {
+	k PaymentKeeper
+}
+
+func NewMessageGasDecorator(k PaymentKeeper) MessageGasDecorator {
+	return MessageGasDecorator{k: k}
+}
+
+// AnteHandle fullfills the sdk.AnteDecorator interface
+func (mgd MessageGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var params *types.Params
+	for _, msg := range tx.GetMsgs() {
+		bytes, commitments := types.MessageCosts(msg)
+		if bytes == 0 && commitments == 0 {
+			continue
+		}
+		// only read the params if the tx is paying for a message
+		if params == nil {
+			p := mgd.k.GetParams(ctx)
+			params = &p
+		}
+		bytesGas, err := mulGas(params.GasPerMessageByte, bytes)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(err, "message bytes")
+		}
+		commitmentsGas, err := mulGas(params.GasPerCommitment, commitments)
+		if err != nil {
+			return ctx, sdkerrors.Wrap(err, "share commitments")
+		}
+		ctx.GasMeter().ConsumeGas(bytesGas, "message bytes")
+		ctx.GasMeter().ConsumeGas(commitmentsGas, "share commitments")
+	}
+	return next(ctx, tx, simulate)
+}
+
+// mulGas returns the gas consumed for the provided number of units, or an
+// out of gas error if it doesn't fit in a uint64
+func mulGas(gasPerUnit, units uint64) (uint64, error) {
+	hi, gas := bits.Mul64(gasPerUnit, units)
+	if hi != 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "gas for %d units at %d gas per unit overflows", units, gasPerUnit)
+	}
+	return gas, nil
+}
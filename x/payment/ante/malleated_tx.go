@@ -0,0 +1,47 @@
+package ante
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// MalleatedTxDecorator only accepts a MsgPayForMessage if it is included in a
+// malleated tx, which is created by the block producer when it includes a
+// MsgWirePayForMessage or MsgWirePayForMessages in a block. Malleated txs must
+// only contain MsgPayForMessages and are never accepted in the mempool. Wire
+// messages are rejected when they are delivered, as their messages were not
+// included in the block.
+type MalleatedTxDecorator struct{}
+
+func NewMalleatedTxDecorator() MalleatedTxDecorator {
+	return MalleatedTxDecorator{}
+}
+
+// AnteHandle fullfills the sdk.AnteDecorator interface
+func (MalleatedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	_, _, isMalleated := coretypes.UnwrapMalleatedTx(ctx.TxBytes())
+	if isMalleated && ctx.IsCheckTx() {
+		return ctx, sdkerrors.Wrap(types.ErrMalformedMalleatedTx, "malleated txs can't be submitted to the mempool")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg.(type) {
+		case *types.MsgPayForMessage:
+			if !isMalleated {
+				return ctx, types.ErrPayForMessageNotMalleated
+			}
+		case *types.MsgWirePayForMessage, *types.MsgWirePayForMessages:
+			if !ctx.IsCheckTx() && !simulate {
+				return ctx, sdkerrors.Wrap(types.ErrUnmalleatedWireMsg, sdk.MsgTypeURL(msg))
+			}
+		default:
+			if isMalleated {
+				return ctx, sdkerrors.Wrapf(types.ErrMalformedMalleatedTx, "malleated tx contains an unexpected %s", sdk.MsgTypeURL(msg))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
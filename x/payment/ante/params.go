@@ -0,0 +1,51 @@
+package ante
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PaymentKeeper restricts the functionality of the payment keeper used by the
+// payment ante decorators
+type PaymentKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	MsgMessageFee(ctx sdk.Context, msg sdk.Msg) sdk.Coins
+	ChargeMessageFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error
+}
+
+// ParamsDecorator checks every MsgWirePayForMessage and MsgWirePayForMessages
+// in a transaction against the current payment module params.
+type ParamsDecorator struct {
+	k PaymentKeeper
+}
+
+func NewParamsDecorator(k PaymentKeeper) ParamsDecorator {
+	return ParamsDecorator{k: k}
+}
+
+// AnteHandle fullfills the sdk.AnteDecorator interface
+func (pd ParamsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var params *types.Params
+	for _, msg := range tx.GetMsgs() {
+		wireMsg, ok := msg.(paramsValidator)
+		if !ok {
+			continue
+		}
+		// only read the params if the tx is paying for a message
+		if params == nil {
+			p := pd.k.GetParams(ctx)
+			params = &p
+		}
+		if err := wireMsg.ValidateWithParams(*params); err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// paramsValidator is implemented by the wire messages that are checked against
+// the payment module params
+type paramsValidator interface {
+	ValidateWithParams(params types.Params) error
+}
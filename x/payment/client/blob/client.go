@@ -0,0 +1,525 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// DefaultGasAdjustment is the default factor applied to the simulated gas
+	// of a blob's transaction
+	DefaultGasAdjustment = 1.2
+	// DefaultPollInterval is the default interval at which a node is queried
+	// to find out if a blob was included in a block
+	DefaultPollInterval = time.Second
+)
+
+// sequenceAttempts is how many times a blob's transaction is signed and
+// broadcasted when it is rejected because of its sequence
+const sequenceAttempts = 2
+
+// Client submits blobs to a celestia-app node over a grpc connection. The
+// transactions are signed by a types.Signer, such as a KeyringSigner or a
+// remote signer, using the account number and sequence tracked by the Client.
+type Client struct {
+	signer  types.Signer
+	conn    *grpc.ClientConn
+	encCfg  cosmoscmd.EncodingConfig
+	chainID string
+
+	// mtx serializes the gas estimation and the broadcast of each blob, as
+	// both depend on the account's sequence
+	mtx           sync.Mutex
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewClient returns a Client that signs blobs using the provided signer. The
+// chain ID, along with the account number and sequence of the signer, are
+// queried from the node.
+func NewClient(ctx context.Context, signer types.Signer, conn *grpc.ClientConn, encCfg cosmoscmd.EncodingConfig) (*Client, error) {
+	res, err := tmservice.NewServiceClient(conn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failure to query the node info: %w", err)
+	}
+
+	c := &Client{signer: signer, conn: conn, encCfg: encCfg, chainID: res.DefaultNodeInfo.Network}
+	if err := c.syncAccount(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SubmitResult describes where the blobs of a transaction were included
+type SubmitResult struct {
+	// Height is the height of the block that includes the blobs
+	Height int64
+	// TxHash is the hash of the wire transaction that was broadcasted
+	TxHash string
+	// ChildTxHash is the hash of the malleated transaction that was included
+	// in the block
+	ChildTxHash string
+	// SquareSize is the size of the block's original data square
+	SquareSize uint64
+	// Blobs holds the location of each blob, in the order they were submitted
+	Blobs []BlobLocation
+}
+
+// BlobLocation describes where a blob is in the block's original data square
+type BlobLocation struct {
+	// Namespace is the namespace of the blob
+	Namespace []byte
+	// ShareCommitment is the commitment to the blob for the square size
+	ShareCommitment []byte
+	// StartShare is the index of the first share of the blob
+	StartShare uint64
+	// EndShare is the index following the last share of the blob
+	EndShare uint64
+}
+
+// Submit pays for the data to be included in the provided namespace, and
+// waits until it is included in a block. Unless specified by the options, the
+// blob commits to every square size of the payment params that it fits in, and
+// the gas is estimated by simulating the transaction. The context should have
+// a deadline, as a blob might never be included.
+func (c *Client) Submit(ctx context.Context, namespace, data []byte, opts ...SubmitOption) (*SubmitResult, error) {
+	return c.submit(ctx, [][]byte{namespace}, [][]byte{data}, opts, func(squareSizes []uint64) (wireMsg, error) {
+		msg, err := types.NewWirePayForMessage(namespace, data, squareSizes...)
+		if err != nil {
+			return nil, err
+		}
+		msg.Signer = c.signer.GetAddress().String()
+		return msg, nil
+	})
+}
+
+// SubmitMany pays for each blob to be included in its corresponding namespace
+// using a single MsgWirePayForMessages, and waits until they are included in a
+// block. Unless specified by the options, the blobs commit to every square
+// size of the payment params that they all fit in together.
+func (c *Client) SubmitMany(ctx context.Context, namespaces, data [][]byte, opts ...SubmitOption) (*SubmitResult, error) {
+	return c.submit(ctx, namespaces, data, opts, func(squareSizes []uint64) (wireMsg, error) {
+		msg, err := types.NewWirePayForMessages(namespaces, data, squareSizes...)
+		if err != nil {
+			return nil, err
+		}
+		msg.Signer = c.signer.GetAddress().String()
+		return msg, nil
+	})
+}
+
+// wireMsg is implemented by MsgWirePayForMessage and MsgWirePayForMessages
+type wireMsg interface {
+	sdk.Msg
+	SignShareCommitments(signer types.Signer, options ...types.TxBuilderOption) error
+}
+
+// submit creates the wire message of the blobs for the square sizes, and
+// broadcasts it before waiting for it to be included in a block
+func (c *Client) submit(
+	ctx context.Context,
+	namespaces, data [][]byte,
+	opts []SubmitOption,
+	newMsg func(squareSizes []uint64) (wireMsg, error),
+) (*SubmitResult, error) {
+	options := defaultSubmitOptions()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	squareSizes := options.squareSizes
+	if len(squareSizes) == 0 {
+		res, err := types.NewQueryClient(c.conn).Params(ctx, &types.QueryParamsRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failure to query the payment params: %w", err)
+		}
+		squareSizes = fittingSquareSizes(res.Params, data)
+		if len(squareSizes) == 0 {
+			return nil, fmt.Errorf("blobs of %d bytes do not fit in any square size", totalSize(data))
+		}
+	}
+
+	msg, err := newMsg(squareSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.broadcast(ctx, msg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := c.waitForInclusion(ctx, resp.TxHash, options.pollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.locate(ctx, height, resp.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Blobs) != len(namespaces) {
+		return nil, fmt.Errorf("malleated tx of %s pays for %d blobs instead of %d", resp.TxHash, len(result.Blobs), len(namespaces))
+	}
+	result.TxHash = resp.TxHash
+	return result, nil
+}
+
+// broadcast estimates the gas of the blobs' transaction, signs it and
+// broadcasts it. If the transaction is rejected because of its sequence, the
+// account is synced with the node and the transaction is signed again.
+func (c *Client) broadcast(ctx context.Context, msg wireMsg, options submitOptions) (*sdk.TxResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for attempt := 1; ; attempt++ {
+		res, err := c.signAndBroadcast(ctx, msg, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Code == abci.CodeTypeOK {
+			c.sequence++
+			return res, nil
+		}
+		if !isWrongSequence(res) || attempt == sequenceAttempts {
+			return nil, sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)
+		}
+		if err := c.syncAccount(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// signAndBroadcast signs the share commitments of the wire message and the
+// transaction containing it using the current sequence, and broadcasts it
+func (c *Client) signAndBroadcast(ctx context.Context, msg wireMsg, options submitOptions) (*sdk.TxResponse, error) {
+	signer := c.accountSigner(ctx)
+
+	gas := options.gasLimit
+	if gas == 0 {
+		var err error
+		gas, err = c.estimateGas(ctx, signer, msg, options.gasAdjustment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fee := options.fee
+	if fee == nil && !options.gasPrice.Amount.IsNil() {
+		amount := options.gasPrice.Amount.MulInt64(int64(gas)).Ceil().RoundInt()
+		fee = sdk.NewCoins(sdk.NewCoin(options.gasPrice.Denom, amount))
+	}
+
+	txOptions := []types.TxBuilderOption{types.SetGasLimit(gas), types.SetFeeAmount(fee)}
+	if err := msg.SignShareCommitments(signer, txOptions...); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.encodeSignedTx(signer, msg, txOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := types.BroadcastTx(ctx, c.conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return res.TxResponse, nil
+}
+
+// estimateGas simulates the blobs' transaction and returns the gas used,
+// multiplied by the gas adjustment
+func (c *Client) estimateGas(ctx context.Context, signer types.Signer, msg wireMsg, gasAdjustment float64) (uint64, error) {
+	txBytes, err := c.encodeSignedTx(signer, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := sdktx.NewServiceClient(c.conn).Simulate(ctx, &sdktx.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, fmt.Errorf("failure to simulate the transaction: %w", err)
+	}
+	return uint64(gasAdjustment * float64(res.GasInfo.GasUsed)), nil
+}
+
+// encodeSignedTx returns the encoded transaction containing the msg, signed by
+// the signer
+func (c *Client) encodeSignedTx(signer types.Signer, msg sdk.Msg, options ...types.TxBuilderOption) ([]byte, error) {
+	builder := signer.NewTxBuilder()
+	for _, option := range options {
+		builder = option(builder)
+	}
+	tx, err := signer.BuildSignedTx(builder, msg)
+	if err != nil {
+		return nil, err
+	}
+	return c.encCfg.TxConfig.TxEncoder()(tx)
+}
+
+// syncAccount queries the account number and sequence of the signer from the
+// node
+func (c *Client) syncAccount(ctx context.Context) error {
+	accNum, sequence, err := types.QueryAccount(ctx, c.conn, c.encCfg, c.signer.GetAddress().String())
+	if err != nil {
+		return err
+	}
+	c.accountNumber, c.sequence = accNum, sequence
+	return nil
+}
+
+// accountSigner returns a signer that signs txs using the current account
+// number and sequence of the Client, on behalf of the context
+func (c *Client) accountSigner(ctx context.Context) accountSigner {
+	return accountSigner{
+		Signer:   c.signer,
+		ctx:      ctx,
+		txConfig: c.encCfg.TxConfig,
+		signerData: authsigning.SignerData{
+			ChainID:       c.chainID,
+			AccountNumber: c.accountNumber,
+			Sequence:      c.sequence,
+		},
+	}
+}
+
+// accountSigner signs txs with the account number and sequence tracked by the
+// Client instead of the ones tracked by the signer. Signers that implement
+// types.ContextSigner stop signing once the context is done.
+type accountSigner struct {
+	types.Signer
+	ctx        context.Context
+	txConfig   sdkclient.TxConfig
+	signerData authsigning.SignerData
+}
+
+// SignBytes fulfills the types.Signer interface
+func (s accountSigner) SignBytes(bz []byte) ([]byte, error) {
+	if signer, ok := s.Signer.(types.ContextSigner); ok {
+		return signer.SignBytesContext(s.ctx, bz)
+	}
+	return s.Signer.SignBytes(bz)
+}
+
+// NewTxBuilder fulfills the types.Signer interface
+func (s accountSigner) NewTxBuilder() sdkclient.TxBuilder {
+	return s.txConfig.NewTxBuilder()
+}
+
+// BuildSignedTx fulfills the types.Signer interface
+func (s accountSigner) BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	return types.SignTx(s, s.txConfig, signing.SignMode_SIGN_MODE_DIRECT, s.signerData, builder, msgs...)
+}
+
+// waitForInclusion polls the node until the transaction with the provided hash
+// is included in a block, and returns the height of the block. Malleated
+// transactions are indexed using the hash of the original transaction.
+func (c *Client) waitForInclusion(ctx context.Context, txHash string, pollInterval time.Duration) (int64, error) {
+	txClient := sdktx.NewServiceClient(c.conn)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := txClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: txHash})
+		if err == nil {
+			if res.TxResponse.Code != abci.CodeTypeOK {
+				return 0, sdkerrors.ABCIError(res.TxResponse.Codespace, res.TxResponse.Code, res.TxResponse.RawLog)
+			}
+			return res.TxResponse.Height, nil
+		}
+		if !isNotFound(err) {
+			return 0, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("waiting for tx %s to be included: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// locate finds the malleated transaction of the blobs in the block at the
+// provided height, along with the shares of each blob in the block's square
+func (c *Client) locate(ctx context.Context, height int64, txHash string) (*SubmitResult, error) {
+	res, err := tmservice.NewServiceClient(c.conn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := locateBlobs(&res.Block.Data, parentHash, c.encCfg.TxConfig.TxDecoder())
+	if err != nil {
+		return nil, fmt.Errorf("block %d: %w", height, err)
+	}
+	result.Height = height
+	return result, nil
+}
+
+// locateBlobs finds the malleated transaction of the parent transaction in the
+// block data, and returns the location of every message it pays for
+func locateBlobs(data *core.Data, parentHash []byte, decoder sdk.TxDecoder) (*SubmitResult, error) {
+	var childTx []byte
+	for _, rawTx := range data.Txs {
+		hash, child, isMalleated := coretypes.UnwrapMalleatedTx(rawTx)
+		if isMalleated && bytes.Equal(hash, parentHash) {
+			childTx = child
+			break
+		}
+	}
+	if childTx == nil {
+		return nil, fmt.Errorf("block does not include the malleated tx of %X", parentHash)
+	}
+
+	tx, err := decoder(childTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.GetMsgs()) == 0 {
+		return nil, errors.New("malleated tx has no msgs")
+	}
+	pfms := make([]*types.MsgPayForMessage, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		pfm, ok := msg.(*types.MsgPayForMessage)
+		if !ok {
+			return nil, fmt.Errorf("unexpected msg in the malleated tx: %s", sdk.MsgTypeURL(msg))
+		}
+		pfms[i] = pfm
+	}
+
+	squareSize, blobs, err := messageShareRanges(data, pfms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubmitResult{
+		ChildTxHash: fmt.Sprintf("%X", tmhash.Sum(childTx)),
+		SquareSize:  squareSize,
+		Blobs:       blobs,
+	}, nil
+}
+
+// messageShareRanges returns the square size of the block data, along with the
+// location of the message paid for by each MsgPayForMessage
+func messageShareRanges(data *core.Data, pfms []*types.MsgPayForMessage) (squareSize uint64, blobs []BlobLocation, err error) {
+	coreData, err := coretypes.DataFromProto(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	shares, _ := coreData.ComputeShares()
+	squareSize = uint64(math.Sqrt(float64(len(shares))))
+
+	blobs = make([]BlobLocation, len(pfms))
+	found := make([]bool, len(pfms))
+	start := uint64(len(coreData.Txs.SplitIntoShares()) +
+		len(coreData.IntermediateStateRoots.SplitIntoShares()) +
+		len(coreData.Evidence.SplitIntoShares()))
+	for _, msg := range coreData.Messages.MessagesList {
+		msgShares := uint64(len(coretypes.Messages{MessagesList: []coretypes.Message{msg}}.SplitIntoShares()))
+		for i, pfm := range pfms {
+			if found[i] || !bytes.Equal(msg.NamespaceID, pfm.MessageNamespaceId) || uint64(len(msg.Data)) != pfm.MessageSize {
+				continue
+			}
+			commit, err := types.CreateCommitment(squareSize, msg.NamespaceID, msg.Data)
+			if err != nil {
+				return 0, nil, err
+			}
+			if bytes.Equal(commit, pfm.MessageShareCommitment) {
+				blobs[i] = BlobLocation{
+					Namespace:       pfm.MessageNamespaceId,
+					ShareCommitment: pfm.MessageShareCommitment,
+					StartShare:      start,
+					EndShare:        start + msgShares,
+				}
+				found[i] = true
+				break
+			}
+		}
+		start += msgShares
+	}
+
+	for i := range pfms {
+		if !found[i] {
+			return 0, nil, fmt.Errorf("block does not include a message matching the share commitment of msg %d", i)
+		}
+	}
+	return squareSize, blobs, nil
+}
+
+// fittingSquareSizes returns the square sizes of the params that have enough
+// shares to hold all of the blobs along with the share used by their
+// transaction
+func fittingSquareSizes(params types.Params, data [][]byte) []uint64 {
+	var shares uint64
+	for _, d := range data {
+		shares += types.MessageShareCount(uint64(len(d)))
+	}
+
+	var fitting []uint64
+	for _, k := range params.SquareSizes {
+		if shares < k*k {
+			fitting = append(fitting, k)
+		}
+	}
+	return fitting
+}
+
+// totalSize returns the total number of bytes of the blobs
+func totalSize(data [][]byte) (size int) {
+	for _, d := range data {
+		size += len(d)
+	}
+	return size
+}
+
+// isNotFound returns true if the error was returned because the node has not
+// indexed the transaction yet. The tx service of the sdk wraps the error of
+// the node in an invalid request error instead of returning a NotFound code,
+// so the message is checked in that case.
+func isNotFound(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch s.Code() {
+	case codes.NotFound:
+		return true
+	case codes.InvalidArgument, codes.Unknown:
+		return strings.Contains(s.Message(), "not found")
+	default:
+		return false
+	}
+}
+
+// isWrongSequence returns true if the transaction was rejected because it was
+// signed with the wrong sequence
+func isWrongSequence(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
@@ -0,0 +1,152 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	core "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLocateBlobs(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	signer := testutil.GenerateKeyringSigner(t, "test")
+	const squareSize = 4
+
+	// the first blob has the higher namespace, so it is written last
+	namespaces := [][]byte{{2, 2, 2, 2, 2, 2, 2, 2}, {1, 1, 1, 1, 1, 1, 1, 1}}
+	blobs := [][]byte{bytes.Repeat([]byte{1}, 600), bytes.Repeat([]byte{2}, 300)}
+	msg, err := types.NewWirePayForMessages(namespaces, blobs, squareSize)
+	require.NoError(t, err)
+	require.NoError(t, msg.SignShareCommitments(signer))
+
+	wireTx, err := signer.BuildSignedTx(signer.NewTxBuilder(), msg)
+	require.NoError(t, err)
+	rawWireTx, err := signer.EncodeTx(wireTx)
+	require.NoError(t, err)
+
+	coreMsgs, pfms, sig, err := types.ProcessWireMsg(msg, squareSize)
+	require.NoError(t, err)
+	childTx, err := types.BuildPayForMessageTxFromWireTx(wireTx, encCfg.TxConfig.NewTxBuilder(), sig, pfms...)
+	require.NoError(t, err)
+	rawChildTx, err := encCfg.TxConfig.TxEncoder()(childTx)
+	require.NoError(t, err)
+	parentHash := sha256.Sum256(rawWireTx)
+	wrappedTx, err := coretypes.WrapMalleatedTx(parentHash[:], rawChildTx)
+	require.NoError(t, err)
+
+	// lay out the messages in the same way as the proposer
+	builder := types.NewSquareLayoutBuilder(squareSize, 0)
+	require.True(t, builder.TryAdd(wrappedTx, coreMsgs))
+	built := builder.Layout()
+	orderedMsgs := make([]*core.Message, len(built.Messages))
+	for i, msgLayout := range built.Messages {
+		orderedMsgs[i] = coreMsgs[msgLayout.Index]
+	}
+	layout := types.NewSquareLayout(squareSize, built.ReservedShares, orderedMsgs)
+	blockMsgs := append(layout.BlockMessages(orderedMsgs), layout.TailPadding()...)
+	data := &core.Data{
+		Txs:      [][]byte{[]byte("regular tx"), wrappedTx},
+		Messages: core.Messages{MessagesList: blockMsgs},
+	}
+
+	res, err := locateBlobs(data, parentHash[:], encCfg.TxConfig.TxDecoder())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(squareSize), res.SquareSize)
+	assert.NotEmpty(t, res.ChildTxHash)
+	require.Len(t, res.Blobs, len(blobs))
+	for i, blob := range res.Blobs {
+		assert.Equal(t, namespaces[i], blob.Namespace)
+		assert.Equal(t, pfms[i].MessageShareCommitment, blob.ShareCommitment)
+		assert.Equal(t, types.MessageSharesUsed(len(blobs[i])), blob.EndShare-blob.StartShare)
+	}
+	assert.LessOrEqual(t, res.Blobs[1].EndShare, res.Blobs[0].StartShare)
+
+	// the shares of each blob hold its message
+	coreData, err := coretypes.DataFromProto(data)
+	require.NoError(t, err)
+	shares, _ := coreData.ComputeShares()
+	for i, blob := range res.Blobs {
+		for j, share := range types.MessageShares(namespaces[i], blobs[i]) {
+			assert.Equal(t, share, []byte(shares[blob.StartShare+uint64(j)].Share))
+		}
+	}
+
+	// a tx that isn't in the block can't be located
+	otherHash := sha256.Sum256([]byte("other tx"))
+	_, err = locateBlobs(data, otherHash[:], encCfg.TxConfig.TxDecoder())
+	assert.Error(t, err)
+
+	// a blob that isn't in the block can't be located
+	data.Messages.MessagesList = blockMsgs[1:]
+	_, err = locateBlobs(data, parentHash[:], encCfg.TxConfig.TxDecoder())
+	assert.Error(t, err)
+}
+
+func TestAccountSigner(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	signer := testutil.GenerateKeyringSigner(t, "test")
+	signer.SetSequence(3)
+
+	c := &Client{signer: signer, encCfg: encCfg, chainID: "test-chain", accountNumber: 5, sequence: 7}
+	msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, []byte{1}, 4)
+	require.NoError(t, err)
+	require.NoError(t, msg.SignShareCommitments(c.accountSigner(context.Background())))
+
+	// the txs are signed with the sequence of the client instead of the one
+	// of the signer
+	txBytes, err := c.encodeSignedTx(c.accountSigner(context.Background()), msg)
+	require.NoError(t, err)
+	tx, err := encCfg.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(7), sigs[0].Sequence)
+	assert.Equal(t, uint64(3), signer.GetSequence())
+}
+
+func TestFittingSquareSizes(t *testing.T) {
+	params := types.DefaultParams()
+	params.SquareSizes = []uint64{4, 8}
+
+	assert.Equal(t, []uint64{4, 8}, fittingSquareSizes(params, [][]byte{make([]byte, 2000)}))
+	// the blobs don't fit together in the smaller square
+	assert.Equal(t, []uint64{8}, fittingSquareSizes(params, [][]byte{make([]byte, 2000), make([]byte, 2000)}))
+	assert.Empty(t, fittingSquareSizes(params, [][]byte{make([]byte, 100000)}))
+}
+
+func TestIsNotFound(t *testing.T) {
+	type test struct {
+		name     string
+		err      error
+		expected bool
+	}
+
+	tests := []test{
+		{"not found code", status.Error(codes.NotFound, "tx not found"), true},
+		{"tx not indexed yet", status.Error(codes.InvalidArgument, "tx (ABCD) not found: invalid request"), true},
+		{"invalid request", status.Error(codes.InvalidArgument, "invalid hash"), false},
+		{"deadline exceeded", status.Error(codes.DeadlineExceeded, "tx not found before the deadline"), false},
+		{"unavailable node", status.Error(codes.Unavailable, "connection refused"), false},
+		{"not a grpc error", errors.New("not found"), false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, isNotFound(tt.err), tt.name)
+	}
+}
@@ -0,0 +1,72 @@
+package blob
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubmitOption configures how a blob is submitted
+type SubmitOption func(*submitOptions)
+
+type submitOptions struct {
+	squareSizes   []uint64
+	gasLimit      uint64
+	gasAdjustment float64
+	fee           sdk.Coins
+	gasPrice      sdk.DecCoin
+	pollInterval  time.Duration
+}
+
+func defaultSubmitOptions() submitOptions {
+	return submitOptions{
+		gasAdjustment: DefaultGasAdjustment,
+		pollInterval:  DefaultPollInterval,
+	}
+}
+
+// WithSquareSizes sets the square sizes that the blob commits to, instead of
+// every square size of the payment params that the blob fits in
+func WithSquareSizes(sizes ...uint64) SubmitOption {
+	return func(o *submitOptions) {
+		o.squareSizes = sizes
+	}
+}
+
+// WithGasLimit sets the gas limit of the blob's transaction, instead of
+// estimating it
+func WithGasLimit(gas uint64) SubmitOption {
+	return func(o *submitOptions) {
+		o.gasLimit = gas
+	}
+}
+
+// WithGasAdjustment sets the factor applied to the estimated gas
+func WithGasAdjustment(adjustment float64) SubmitOption {
+	return func(o *submitOptions) {
+		o.gasAdjustment = adjustment
+	}
+}
+
+// WithFee sets the fee paid by the blob's transaction
+func WithFee(fee sdk.Coins) SubmitOption {
+	return func(o *submitOptions) {
+		o.fee = fee
+	}
+}
+
+// WithGasPrice sets the price paid for each unit of gas used by the blob's
+// transaction. It is ignored if the fee is set.
+func WithGasPrice(price sdk.DecCoin) SubmitOption {
+	return func(o *submitOptions) {
+		o.gasPrice = price
+	}
+}
+
+// WithPollInterval sets the interval at which the node is queried to find
+// out if the blob was included in a block
+func WithPollInterval(interval time.Duration) SubmitOption {
+	return func(o *submitOptions) {
+		o.pollInterval = interval
+	}
+}
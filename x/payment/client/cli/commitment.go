@@ -0,0 +1,269 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	// FlagCommitment is the flag used to provide the share commitment that is
+	// verified
+	FlagCommitment = "commitment"
+	// FlagCommitmentEncoding is the flag used to select the encoding of the
+	// share commitment
+	FlagCommitmentEncoding = "commitment-encoding"
+)
+
+// commitmentResult is printed by the commitment compute command
+type commitmentResult struct {
+	Namespace   string                 `json:"namespace"`
+	MessageSize uint64                 `json:"message_size"`
+	Shares      uint64                 `json:"shares"`
+	Commitments []squareSizeCommitment `json:"commitments"`
+}
+
+// squareSizeCommitment is the share commitment of a message for a single
+// square size, along with the roots of the subtrees that it commits to
+type squareSizeCommitment struct {
+	SquareSize      uint64        `json:"square_size"`
+	ShareCommitment string        `json:"share_commitment"`
+	Subtrees        []subtreeRoot `json:"subtrees"`
+}
+
+type subtreeRoot struct {
+	Width uint64 `json:"width"`
+	Root  string `json:"root"`
+}
+
+// verifyResult is printed by the commitment verify command
+type verifyResult struct {
+	Valid      bool   `json:"valid"`
+	SquareSize uint64 `json:"square_size,omitempty"`
+}
+
+// CmdCommitment returns the commands used to compute and verify share
+// commitments without a running node
+func CmdCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "commitment",
+		Short:                      "Compute and verify message share commitments offline",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdComputeCommitment())
+	cmd.AddCommand(CmdVerifyCommitment())
+
+	return cmd
+}
+
+func CmdComputeCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compute [namespace] [message]",
+		Short: "Compute the share commitments of a message",
+		Long: `Compute the share commitment of a message for each square size, using the same
+rules as the commitments included in a MsgPayForMessage. The roots of the
+subtrees of the merkle mountain range that each commitment is created over are
+printed along with the commitment.
+
+The message is read in the same way as by the payForMessage command. If no
+square sizes are provided, the default square sizes that fit the message are
+used.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, message, squareSizes, err := readCommitmentInput(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			res := commitmentResult{
+				Namespace:   hex.EncodeToString(namespace),
+				MessageSize: uint64(len(message)),
+				Shares:      types.MessageShareCount(uint64(len(message))),
+				Commitments: make([]squareSizeCommitment, len(squareSizes)),
+			}
+			for i, k := range squareSizes {
+				subtrees, err := types.CommitmentSubtrees(k, namespace, message)
+				if err != nil {
+					return fmt.Errorf("square size %d: %w", k, err)
+				}
+				commit, err := types.CreateCommitment(k, namespace, message)
+				if err != nil {
+					return fmt.Errorf("square size %d: %w", k, err)
+				}
+
+				res.Commitments[i] = squareSizeCommitment{
+					SquareSize:      k,
+					ShareCommitment: hex.EncodeToString(commit),
+					Subtrees:        make([]subtreeRoot, len(subtrees)),
+				}
+				for j, subtree := range subtrees {
+					res.Commitments[i].Subtrees[j] = subtreeRoot{
+						Width: subtree.Width,
+						Root:  hex.EncodeToString(subtree.Root),
+					}
+				}
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	addCommitmentFlags(cmd)
+
+	return cmd
+}
+
+func CmdVerifyCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [namespace] [message]",
+		Short: "Verify that a share commitment matches a message",
+		Long: `Verify that the share commitment provided using --commitment matches the message
+for one of the square sizes. The square size that the commitment matches is
+printed, and an error is returned if it doesn't match any of them.
+
+The message is read in the same way as by the payForMessage command. If no
+square sizes are provided, the default square sizes that fit the message are
+checked.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expected, err := readCommitment(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, message, squareSizes, err := readCommitmentInput(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			var res verifyResult
+			for _, k := range squareSizes {
+				commit, err := types.CreateCommitment(k, namespace, message)
+				if err != nil {
+					return fmt.Errorf("square size %d: %w", k, err)
+				}
+				if bytes.Equal(commit, expected) {
+					res = verifyResult{Valid: true, SquareSize: k}
+					break
+				}
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			err = clientCtx.PrintBytes(bz)
+			if err != nil {
+				return err
+			}
+
+			if !res.Valid {
+				return fmt.Errorf("the commitment does not match the message for any of the square sizes %v", squareSizes)
+			}
+			return nil
+		},
+	}
+
+	addCommitmentFlags(cmd)
+	cmd.Flags().String(FlagCommitment, "", "The share commitment to verify")
+	cmd.Flags().String(FlagCommitmentEncoding, EncodingHex, fmt.Sprintf("Encoding of the share commitment (%s|%s)", EncodingHex, EncodingBase64))
+	_ = cmd.MarkFlagRequired(FlagCommitment)
+
+	return cmd
+}
+
+// addCommitmentFlags adds the flags shared by the commitment commands
+func addCommitmentFlags(cmd *cobra.Command) {
+	addMessageInputFlags(cmd)
+	cmd.Flags().UintSlice(FlagSquareSizes, []uint{}, "Specify the square sizes, must be power of 2. Defaults to the default square sizes that fit the message")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+}
+
+// readCommitmentInput returns the namespace, the message, and the square
+// sizes provided to a commitment command
+func readCommitmentInput(cmd *cobra.Command, args []string) ([]byte, []byte, []uint64, error) {
+	namespace, err := readNamespace(cmd, args)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(namespace) != types.NamespaceIDSize {
+		return nil, nil, nil, fmt.Errorf("invalid namespace length: got %d wanted %d", len(namespace), types.NamespaceIDSize)
+	}
+
+	message, err := readMessage(cmd, args[1:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sizes, err := cmd.Flags().GetUintSlice(FlagSquareSizes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	squareSizes := parseSquareSizes(sizes)
+	if len(squareSizes) == 0 {
+		squareSizes = types.DefaultParams().FittingSquareSizes(uint64(len(message)))
+	}
+	if len(squareSizes) == 0 {
+		return nil, nil, nil, fmt.Errorf("the message does not fit in any of the default square sizes")
+	}
+	for _, k := range squareSizes {
+		if k == 0 || k&(k-1) != 0 {
+			return nil, nil, nil, fmt.Errorf("invalid square size, the size must be power of 2: %d", k)
+		}
+	}
+
+	return namespace, message, squareSizes, nil
+}
+
+// readCommitment returns the share commitment provided using --commitment
+func readCommitment(cmd *cobra.Command) ([]byte, error) {
+	commitment, err := cmd.Flags().GetString(FlagCommitment)
+	if err != nil {
+		return nil, err
+	}
+	encoding, err := cmd.Flags().GetString(FlagCommitmentEncoding)
+	if err != nil {
+		return nil, err
+	}
+	switch encoding {
+	case EncodingHex:
+		commit, err := hex.DecodeString(commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode hex commitment: %w", err)
+		}
+		return commit, nil
+	case EncodingBase64:
+		commit, err := base64.StdEncoding.DecodeString(commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode base64 commitment: %w", err)
+		}
+		return commit, nil
+	default:
+		return nil, fmt.Errorf("unknown commitment encoding %q", encoding)
+	}
+}
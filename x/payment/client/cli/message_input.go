@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+)
+
+const (
+	// FlagFile is the flag used to read the message from a file, or from
+	// stdin if the file is "-"
+	FlagFile = "file"
+	// FlagEncoding is the flag used to select the encoding of the message
+	FlagEncoding = "encoding"
+	// FlagNamespaceEncoding is the flag used to select how the namespace
+	// argument is turned into a namespace ID
+	FlagNamespaceEncoding = "namespace-encoding"
+
+	// EncodingHex decodes hex encoded data
+	EncodingHex = "hex"
+	// EncodingBase64 decodes standard base64 encoded data
+	EncodingBase64 = "base64"
+	// EncodingRaw uses the data as is
+	EncodingRaw = "raw"
+
+	// NamespaceEncodingHash uses the first NamespaceIDSize bytes of the
+	// sha256 hash of the namespace string as the namespace ID
+	NamespaceEncodingHash = "hash"
+	// NamespaceEncodingPad right pads the namespace string with zeros to
+	// NamespaceIDSize bytes
+	NamespaceEncodingPad = "pad"
+
+	// stdinFile is the file name and message argument used to read the
+	// message from stdin
+	stdinFile = "-"
+)
+
+// addMessageInputFlags adds the flags used to read the namespace and the
+// message to the command
+func addMessageInputFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagFile, "", "Read the message from the file instead of the arguments, or from stdin if the file is \"-\"")
+	cmd.Flags().String(FlagEncoding, "", fmt.Sprintf("Encoding of the message (%s|%s|%s). Defaults to %s for arguments, and %s for files and stdin", EncodingHex, EncodingBase64, EncodingRaw, EncodingHex, EncodingRaw))
+	cmd.Flags().String(FlagNamespaceEncoding, EncodingHex, fmt.Sprintf("Encoding of the namespace (%s|%s|%s)", EncodingHex, NamespaceEncodingHash, NamespaceEncodingPad))
+}
+
+// readNamespace returns the namespace ID provided as the first argument,
+// decoded using the --namespace-encoding flag
+func readNamespace(cmd *cobra.Command, args []string) ([]byte, error) {
+	encoding, err := cmd.Flags().GetString(FlagNamespaceEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return parseNamespace(args[0], encoding)
+}
+
+// readMessage returns the decoded message provided to the command. It is
+// read from the file provided using --file, from stdin if the message argument
+// is "-", or from the message argument otherwise. Messages read from a file or
+// stdin are raw by default, while messages provided as an argument are hex
+// encoded by default.
+func readMessage(cmd *cobra.Command, args []string) ([]byte, error) {
+	file, err := cmd.Flags().GetString(FlagFile)
+	if err != nil {
+		return nil, err
+	}
+	encoding, err := cmd.Flags().GetString(FlagEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case file != "" && len(args) != 0:
+		return nil, fmt.Errorf("the message can't be provided both as an argument and using --%s", FlagFile)
+	case file == "" && len(args) == 0:
+		return nil, fmt.Errorf("no message provided, please provide it as an argument or using --%s", FlagFile)
+	case file == "" && args[0] != stdinFile:
+		if encoding == "" {
+			encoding = EncodingHex
+		}
+		return decodeMessage([]byte(args[0]), encoding)
+	}
+
+	var data []byte
+	if file == "" || file == stdinFile {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failure to read the message: %w", err)
+	}
+
+	if encoding == "" {
+		encoding = EncodingRaw
+	}
+	return decodeMessage(data, encoding)
+}
+
+// decodeMessage decodes the message using the provided encoding. Surrounding
+// whitespace is ignored for the text encodings.
+func decodeMessage(data []byte, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingHex:
+		message, err := hex.DecodeString(string(bytes.TrimSpace(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode hex message: %w", err)
+		}
+		return message, nil
+	case EncodingBase64:
+		message, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode base64 message: %w", err)
+		}
+		return message, nil
+	case EncodingRaw:
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unknown message encoding %q", encoding)
+	}
+}
+
+// parseNamespace turns the namespace argument into a namespace ID using the
+// provided encoding
+func parseNamespace(namespace, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingHex:
+		nid, err := hex.DecodeString(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode hex namespace: %w", err)
+		}
+		return nid, nil
+	case NamespaceEncodingHash:
+		hash := sha256.Sum256([]byte(namespace))
+		return hash[:types.NamespaceIDSize], nil
+	case NamespaceEncodingPad:
+		if len(namespace) > types.NamespaceIDSize {
+			return nil, fmt.Errorf("namespace %q is longer than %d bytes", namespace, types.NamespaceIDSize)
+		}
+		nid := make([]byte, types.NamespaceIDSize)
+		copy(nid, namespace)
+		return nid, nil
+	default:
+		return nil, errors.New("unknown namespace encoding, expected one of hex, hash, or pad")
+	}
+}
@@ -24,6 +24,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdPaidMessages())
+	cmd.AddCommand(CmdListPaidMessages())
+	cmd.AddCommand(CmdShowNamespace())
+	cmd.AddCommand(CmdListNamespaces())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagMinHeight = "min-height"
+	FlagMaxHeight = "max-height"
+)
+
+func CmdListPaidMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-paid-messages",
+		Short: "list the messages paid for in every namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPaidMessagesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllPaidMessages(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPaidMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paid-messages [hexNamespace]",
+		Short: "list the messages paid for in a namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failure to decode hex namespace: %w", err)
+			}
+
+			minHeight, err := cmd.Flags().GetInt64(FlagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetInt64(FlagMaxHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPaidMessagesRequest{
+				NamespaceId: namespace,
+				MinHeight:   minHeight,
+				MaxHeight:   maxHeight,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.PaidMessages(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagMinHeight, 0, "Only list messages included at or above this height")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "Only list messages included at or below this height, 0 means no limit")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// wireMsg is a MsgWirePayForMessage or MsgWirePayForMessages
+type wireMsg interface {
+	sdk.Msg
+	SignShareCommitments(signer types.Signer, options ...types.TxBuilderOption) error
+}
+
+func CmdSignWire() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-wire [file]",
+		Short: "Sign the share commitments and the tx of an unsigned wire message",
+		Long: `Sign an unsigned tx containing a MsgWirePayForMessage or MsgWirePayForMessages,
+such as one created using payForMessage --generate-only. Every share commitment
+is signed using the gas and fee of the tx, followed by the tx itself. The signed
+tx can be broadcasted using the tx broadcast command.
+
+When the --offline flag is used, the account number and sequence of the signer
+must be provided using the --account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msgs := stdTx.GetMsgs()
+			if len(msgs) != 1 {
+				return fmt.Errorf("expected a single wire message, got %d messages", len(msgs))
+			}
+			msg, ok := msgs[0].(wireMsg)
+			if !ok {
+				return fmt.Errorf("expected a wire message, got %s", sdk.MsgTypeURL(msgs[0]))
+			}
+			if !msg.GetSigners()[0].Equals(clientCtx.GetFromAddress()) {
+				return errors.New("the wire message must be signed by the --from account")
+			}
+
+			txf, err := prepareFactory(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			signer, err := newKeyringSigner(clientCtx, txf)
+			if err != nil {
+				return err
+			}
+
+			feeTx, ok := stdTx.(sdk.FeeTx)
+			if !ok {
+				return errors.New("invalid transaction type")
+			}
+
+			// sign the share commitments using the gas and fee of the tx, which
+			// are copied into the malleated tx
+			err = msg.SignShareCommitments(
+				signer,
+				types.SetGasLimit(feeTx.GetGas()),
+				types.SetFeeAmount(feeTx.GetFee()),
+			)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+			err = txBuilder.SetMsgs(msg)
+			if err != nil {
+				return err
+			}
+
+			err = tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true)
+			if err != nil {
+				return err
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				cmd.Printf("%s\n", json)
+				return nil
+			}
+
+			fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				closeErr := fp.Close()
+				if err == nil {
+					err = closeErr
+				}
+			}()
+
+			_, err = fp.Write(append(json, '\n'))
+			return err
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+
+	return cmd
+}
@@ -26,6 +26,11 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdWirePayForMessage())
+	cmd.AddCommand(CmdSignWire())
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdTransferNamespace())
+	cmd.AddCommand(CmdUpdateNamespaceWriters())
+	cmd.AddCommand(CmdUnregisterNamespace())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
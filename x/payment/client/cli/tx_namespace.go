@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-namespace [hexNamespace]",
+		Short: "Registers a namespace, restricting who can pay for messages in it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failure to decode hex namespace: %w", err)
+			}
+
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress().String(), namespace)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTransferNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-namespace [hexNamespace] [newOwner]",
+		Short: "Transfers the ownership of a registered namespace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failure to decode hex namespace: %w", err)
+			}
+
+			msg := types.NewMsgTransferNamespace(clientCtx.GetFromAddress().String(), namespace, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateNamespaceWriters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-namespace-writers [hexNamespace] [writers...]",
+		Short: "Replaces the accounts, other than the owner, that can pay for messages in a registered namespace",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failure to decode hex namespace: %w", err)
+			}
+
+			msg := types.NewMsgUpdateNamespaceWriters(clientCtx.GetFromAddress().String(), namespace, args[1:])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnregisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-namespace [hexNamespace]",
+		Short: "Releases a registered namespace and refunds its deposit to the owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failure to decode hex namespace: %w", err)
+			}
+
+			msg := types.NewMsgUnregisterNamespace(clientCtx.GetFromAddress().String(), namespace)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
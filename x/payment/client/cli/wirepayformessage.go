@@ -2,55 +2,65 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/tendermint/pkg/consts"
 
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const FlagSquareSizes = "square-sizes"
 
 func CmdWirePayForMessage() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "payForMessage [hexNamespace] [hexMessage]",
+		Use:   "payForMessage [namespace] [message]",
 		Short: "Creates a new MsgWirePayForMessage",
-		Args:  cobra.ExactArgs(2),
+		Long: `Creates a new MsgWirePayForMessage, signs a share commitment for each square
+size, and broadcasts it.
+
+The message is provided as a hex encoded argument by default. It can instead be
+read from a file using --file, or from stdin by passing "-" as the message.
+Messages read from a file or stdin are used as is, unless a different
+--encoding is selected. The namespace is hex encoded by default, or can be a
+string that is hashed or right padded to the size of a namespace ID using
+--namespace-encoding.
+
+When the --dry-run flag is used, the share commitments, share count, and fees
+are printed without signing or broadcasting the tx. The gas is estimated by
+simulating the tx, unless the --offline flag is used.
+
+When the --generate-only flag is used, the tx is written without signing the
+share commitments, so that it can be signed using the sign-wire command. The
+square sizes must be provided using the --square-sizes flag when the --offline
+flag is used.`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// query for account number
 			fromAddress := clientCtx.GetFromAddress()
-			account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, fromAddress)
-			if err != nil {
-				return err
-			}
-
-			// get the account name
-			accName := clientCtx.GetFromName()
-			if accName == "" {
-				return errors.New("no account name provided, please use the --from flag")
-			}
 
 			// decode the namespace
-			namespace, err := hex.DecodeString(args[0])
+			namespace, err := readNamespace(cmd, args)
 			if err != nil {
-				return fmt.Errorf("failure to decode hex namespace: %w", err)
+				return err
 			}
 
-			// decode the message
-			message, err := hex.DecodeString(args[1])
+			// read and decode the message
+			message, err := readMessage(cmd, args[1:])
 			if err != nil {
-				return fmt.Errorf("failure to decode hex message: %w", err)
+				return err
 			}
 
 			// create the MsgPayForMessage
@@ -59,33 +69,73 @@ func CmdWirePayForMessage() *cobra.Command {
 				return err
 			}
 			squareSizes64 := parseSquareSizes(squareSizes)
+			// if no square sizes are specified, commit to every square size
+			// allowed by the payment module that the message can fit in
+			if len(squareSizes64) == 0 {
+				if clientCtx.Offline {
+					return fmt.Errorf("the square sizes must be provided using --%s when offline", FlagSquareSizes)
+				}
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+				if err != nil {
+					return fmt.Errorf("failure to query the payment params: %w", err)
+				}
+				squareSizes64 = res.Params.FittingSquareSizes(uint64(len(message)))
+			}
 			pfmMsg, err := types.NewWirePayForMessage(namespace, message, squareSizes64...)
 			if err != nil {
 				return err
 			}
+			pfmMsg.Signer = fromAddress.String()
 
-			// use the keyring to programmatically sign multiple PayForMessage txs
-			signer := types.NewKeyringSigner(clientCtx.Keyring, accName, clientCtx.ChainID)
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			// the account is only needed to sign the share commitments, or to
+			// simulate the tx
+			if !clientCtx.GenerateOnly || txf.SimulateAndExecute() {
+				txf, err = prepareFactory(clientCtx, txf)
+				if err != nil {
+					return err
+				}
+			}
 
-			signer.SetAccountNumber(account.GetAccountNumber())
-			signer.SetSequence(account.GetSequence())
+			// the share commitments are signed over the gas limit, so the gas
+			// has to be estimated before they are signed. The gas is also
+			// estimated for dry runs, unless offline.
+			if txf.SimulateAndExecute() || (clientCtx.Simulate && !clientCtx.Offline) {
+				_, gas, err := tx.CalculateGas(clientCtx, txf, pfmMsg)
+				if err != nil {
+					return err
+				}
+				txf = txf.WithGas(gas).WithSimulateAndExecute(false)
+				_, _ = fmt.Fprintf(os.Stderr, "%s\n", tx.GasEstimateResponse{GasEstimate: gas})
+			}
 
-			// get and parse the gas limit for this tx
-			rawGasLimit, err := cmd.Flags().GetString(flags.FlagGas)
-			if err != nil {
-				return err
+			if clientCtx.Simulate {
+				if err = pfmMsg.ValidateBasic(); err != nil {
+					return err
+				}
+				return printDryRun(cmd, clientCtx, txf, pfmMsg)
 			}
-			gasSetting, err := flags.ParseGasSetting(rawGasLimit)
-			if err != nil {
-				return err
+
+			// the unsigned tx is written without signing the share commitments,
+			// which are signed along with the tx using the sign-wire command
+			if clientCtx.GenerateOnly {
+				if err = pfmMsg.ValidateBasic(); err != nil {
+					return err
+				}
+				return tx.GenerateTx(clientCtx, txf, pfmMsg)
 			}
 
-			// get and parse the fees for this tx
-			fees, err := cmd.Flags().GetString(flags.FlagFees)
+			// use the keyring to programmatically sign multiple PayForMessage txs
+			signer, err := newKeyringSigner(clientCtx, txf)
 			if err != nil {
 				return err
 			}
-			parsedFees, err := sdk.ParseCoinsNormalized(fees)
+
+			// use the fees of the tx that is broadcasted, which are computed
+			// from the gas prices if they are provided
+			unsignedTx, err := tx.BuildUnsignedTx(txf, pfmMsg)
 			if err != nil {
 				return err
 			}
@@ -93,8 +143,8 @@ func CmdWirePayForMessage() *cobra.Command {
 			// sign the  MsgPayForMessage's ShareCommitments
 			err = pfmMsg.SignShareCommitments(
 				signer,
-				types.SetGasLimit(gasSetting.Gas),
-				types.SetFeeAmount(parsedFees),
+				types.SetGasLimit(txf.Gas()),
+				types.SetFeeAmount(unsignedTx.GetTx().GetFee()),
 			)
 			if err != nil {
 				return err
@@ -104,12 +154,13 @@ func CmdWirePayForMessage() *cobra.Command {
 			if err = pfmMsg.ValidateBasic(); err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), pfmMsg)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, pfmMsg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().UintSlice(FlagSquareSizes, []uint{consts.MaxSquareSize, 128, 64}, "Specify the square sizes, must be power of 2")
+	cmd.Flags().UintSlice(FlagSquareSizes, []uint{}, "Specify the square sizes, must be power of 2. Defaults to the square sizes of the payment params that fit the message")
+	addMessageInputFlags(cmd)
 
 	return cmd
 }
@@ -120,4 +171,120 @@ func parseSquareSizes(squareSizes []uint) []uint64 {
 		squareSizes64[i] = uint64(squareSizes[i])
 	}
 	return squareSizes64
-}
\ No newline at end of file
+}
+
+// prepareFactory sets the account number and sequence of the --from account
+// in the factory, like the sdk does before broadcasting a tx. When they are not
+// provided using the --account-number and --sequence flags, they are queried
+// from the node, unless the tx is created offline.
+func prepareFactory(clientCtx client.Context, txf tx.Factory) (tx.Factory, error) {
+	if clientCtx.Offline {
+		return txf, nil
+	}
+
+	from := clientCtx.GetFromAddress()
+	if err := txf.AccountRetriever().EnsureExists(clientCtx, from); err != nil {
+		return txf, err
+	}
+
+	initNum, initSeq := txf.AccountNumber(), txf.Sequence()
+	if initNum == 0 || initSeq == 0 {
+		num, seq, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+		if err != nil {
+			return txf, err
+		}
+
+		if initNum == 0 {
+			txf = txf.WithAccountNumber(num)
+		}
+		if initSeq == 0 {
+			txf = txf.WithSequence(seq)
+		}
+	}
+	return txf, nil
+}
+
+// newKeyringSigner returns a KeyringSigner for the --from account that signs
+// share commitments using the account number, sequence, and sign mode of the
+// factory
+func newKeyringSigner(clientCtx client.Context, txf tx.Factory) (*types.KeyringSigner, error) {
+	accName := clientCtx.GetFromName()
+	if accName == "" {
+		return nil, errors.New("no account name provided, please use the --from flag")
+	}
+
+	signer := types.NewKeyringSigner(clientCtx.Keyring, accName, clientCtx.ChainID)
+	signer.SetAccountNumber(txf.AccountNumber())
+	signer.SetSequence(txf.Sequence())
+
+	// sign the share commitments using the same sign mode as the tx
+	if txf.SignMode() != signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signer.SetSignMode(txf.SignMode())
+	}
+	return signer, nil
+}
+
+// dryRunResult is printed by payForMessage --dry-run
+type dryRunResult struct {
+	Namespace   string             `json:"namespace"`
+	MessageSize uint64             `json:"message_size"`
+	Shares      uint64             `json:"shares"`
+	Commitments []dryRunCommitment `json:"commitments"`
+	Gas         uint64             `json:"gas"`
+	// Fee is the fee of the tx
+	Fee string `json:"fee"`
+	// MessageFee is the fee charged per share of the message, which is only
+	// known when online
+	MessageFee string `json:"message_fee,omitempty"`
+}
+
+// dryRunCommitment is the share commitment of a message for a single square
+// size
+type dryRunCommitment struct {
+	SquareSize      uint64 `json:"square_size"`
+	ShareCommitment string `json:"share_commitment"`
+}
+
+// printDryRun prints the share commitments of the MsgWirePayForMessage, along
+// with the number of shares used by the message and the fees that would be
+// paid for it
+func printDryRun(cmd *cobra.Command, clientCtx client.Context, txf tx.Factory, msg *types.MsgWirePayForMessage) error {
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		return err
+	}
+
+	res := dryRunResult{
+		Namespace:   hex.EncodeToString(msg.MessageNameSpaceId),
+		MessageSize: msg.MessageSize,
+		Shares:      types.MessageShareCount(msg.MessageSize),
+		Commitments: make([]dryRunCommitment, len(msg.MessageShareCommitment)),
+		Gas:         txf.Gas(),
+		Fee:         unsignedTx.GetTx().GetFee().String(),
+	}
+	for i, commit := range msg.MessageShareCommitment {
+		res.Commitments[i] = dryRunCommitment{
+			SquareSize:      commit.K,
+			ShareCommitment: hex.EncodeToString(commit.ShareCommitment),
+		}
+	}
+
+	if !clientCtx.Offline {
+		params, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+		if err != nil {
+			return fmt.Errorf("failure to query the payment params: %w", err)
+		}
+		stakingParams, err := stakingtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &stakingtypes.QueryParamsRequest{})
+		if err != nil {
+			return fmt.Errorf("failure to query the staking params: %w", err)
+		}
+		shares := sdk.NewIntFromUint64(res.Shares)
+		res.MessageFee = sdk.NewCoin(stakingParams.Params.BondDenom, shares.Mul(params.Params.FeePerShare)).String()
+	}
+
+	bz, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(bz)
+}
@@ -0,0 +1,53 @@
+package remotesigner
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.RemoteSignerServer = &Server{}
+
+// Server implements the RemoteSigner service by signing bytes with the
+// provided Signer. Paired with a KeyringSigner, it can be run locally as a
+// stand-in for a remote signing service.
+type Server struct {
+	signer types.Signer
+}
+
+// NewServer returns a RemoteSigner service that signs bytes with the signer
+func NewServer(signer types.Signer) *Server {
+	return &Server{signer: signer}
+}
+
+// PubKey implements the RemoteSigner/PubKey RPC method
+func (s *Server) PubKey(ctx context.Context, req *types.PubKeyRequest) (*types.PubKeyResponse, error) {
+	pubKey, err := s.signer.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PubKeyResponse{PubKey: any}, nil
+}
+
+// SignBytes implements the RemoteSigner/SignBytes RPC method. Signers that
+// implement types.ContextSigner stop signing once the request is canceled.
+func (s *Server) SignBytes(ctx context.Context, req *types.SignBytesRequest) (*types.SignBytesResponse, error) {
+	var (
+		sig []byte
+		err error
+	)
+	if signer, ok := s.signer.(types.ContextSigner); ok {
+		sig, err = signer.SignBytesContext(ctx, req.SignBytes)
+	} else {
+		sig, err = s.signer.SignBytes(req.SignBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignBytesResponse{Signature: sig}, nil
+}
@@ -0,0 +1,214 @@
+package remotesigner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/tendermint/spm/cosmoscmd"
+	"google.golang.org/grpc"
+)
+
+var (
+	_ types.Signer        = &Signer{}
+	_ types.ContextSigner = &Signer{}
+)
+
+// DefaultSignTimeout is the default time a Signer waits for the RemoteSigner
+// service to sign bytes
+const DefaultSignTimeout = 10 * time.Second
+
+// Signer implements the types.Signer interface using a RemoteSigner service,
+// so that the private key of the account never leaves the service. The
+// account number and sequence used to sign transactions are kept locally.
+type Signer struct {
+	client  types.RemoteSignerClient
+	pubKey  cryptotypes.PubKey
+	chainID string
+	encCfg  cosmoscmd.EncodingConfig
+
+	mtx           sync.RWMutex
+	accountNumber uint64
+	sequence      uint64
+	signMode      signing.SignMode
+	signTimeout   time.Duration
+}
+
+// NewSigner returns a Signer that signs transactions for the provided chain
+// using the RemoteSigner service reachable over the connection. The public key
+// of the account is queried from the service.
+func NewSigner(ctx context.Context, conn *grpc.ClientConn, encCfg cosmoscmd.EncodingConfig, chainID string) (*Signer, error) {
+	client := types.NewRemoteSignerClient(conn)
+	res, err := client.PubKey(ctx, &types.PubKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	err = encCfg.InterfaceRegistry.UnpackAny(res.PubKey, &pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		client:      client,
+		pubKey:      pubKey,
+		chainID:     chainID,
+		encCfg:      encCfg,
+		signMode:    signing.SignMode_SIGN_MODE_DIRECT,
+		signTimeout: DefaultSignTimeout,
+	}, nil
+}
+
+// GetAddress returns the address of the signing account
+func (s *Signer) GetAddress() sdk.AccAddress {
+	return sdk.AccAddress(s.pubKey.Address())
+}
+
+// GetPubKey returns the public key of the signing account
+func (s *Signer) GetPubKey() (cryptotypes.PubKey, error) {
+	return s.pubKey, nil
+}
+
+// SignBytes signs the provided bytes using the RemoteSigner service. The
+// request is canceled if the service doesn't respond within the timeout set via
+// s.SetSignTimeout, which defaults to DefaultSignTimeout. Use s.WithContext or
+// s.SignBytesContext to also cancel it along with a context.
+func (s *Signer) SignBytes(bz []byte) ([]byte, error) {
+	return s.SignBytesContext(context.Background(), bz)
+}
+
+// SignBytesContext signs the provided bytes using the RemoteSigner service.
+// The request is canceled once the context is done, or if the service doesn't
+// respond within the sign timeout.
+func (s *Signer) SignBytesContext(ctx context.Context, bz []byte) ([]byte, error) {
+	s.mtx.RLock()
+	timeout := s.signTimeout
+	s.mtx.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.client.SignBytes(ctx, &types.SignBytesRequest{SignBytes: bz})
+	if err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// WithContext returns a types.Signer that signs using s, and whose requests to
+// the RemoteSigner service are canceled once the context is done. It allows
+// callers of helpers that only accept a types.Signer, such as
+// SignShareCommitments, to cancel the signing.
+func (s *Signer) WithContext(ctx context.Context) types.Signer {
+	return contextSigner{Signer: s, ctx: ctx}
+}
+
+// contextSigner signs bytes with the RemoteSigner service on behalf of a
+// context
+type contextSigner struct {
+	*Signer
+	ctx context.Context
+}
+
+// SignBytes fulfills the types.Signer interface
+func (s contextSigner) SignBytes(bz []byte) ([]byte, error) {
+	return s.SignBytesContext(s.ctx, bz)
+}
+
+// BuildSignedTx fulfills the types.Signer interface
+func (s contextSigner) BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	return s.buildSignedTx(s, builder, msgs...)
+}
+
+// NewTxBuilder returns the default sdk Tx builder using the signer's encoding
+// config
+func (s *Signer) NewTxBuilder() sdkclient.TxBuilder {
+	return s.encCfg.TxConfig.NewTxBuilder()
+}
+
+// BuildSignedTx creates and signs a transaction that contains the provided
+// msgs. The account number must be set by calling s.QueryAccountNumber or
+// s.SetAccountNumber for the built transactions to be valid. The transaction is
+// signed using the sign mode set via s.SetSignMode, which defaults to
+// SIGN_MODE_DIRECT.
+func (s *Signer) BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	return s.buildSignedTx(s, builder, msgs...)
+}
+
+// buildSignedTx creates a transaction containing the msgs, which is signed by
+// the provided signer using the account number, sequence and sign mode of s
+func (s *Signer) buildSignedTx(signer types.Signer, builder sdkclient.TxBuilder, msgs ...sdk.Msg) (authsigning.Tx, error) {
+	s.mtx.RLock()
+	signerData := authsigning.SignerData{
+		ChainID:       s.chainID,
+		AccountNumber: s.accountNumber,
+		Sequence:      s.sequence,
+	}
+	signMode := s.signMode
+	s.mtx.RUnlock()
+
+	return types.SignTx(signer, s.encCfg.TxConfig, signMode, signerData, builder, msgs...)
+}
+
+// EncodeTx encodes the provided transaction using the signer's encoding config
+func (s *Signer) EncodeTx(tx sdk.Tx) ([]byte, error) {
+	return s.encCfg.TxConfig.TxEncoder()(tx)
+}
+
+// QueryAccountNumber queries the account number and sequence of the signing
+// account from the celestia-app node reachable over the connection
+func (s *Signer) QueryAccountNumber(ctx context.Context, conn *grpc.ClientConn) error {
+	accNum, sequence, err := types.QueryAccount(ctx, conn, s.encCfg, s.GetAddress().String())
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.accountNumber = accNum
+	s.sequence = sequence
+	return nil
+}
+
+// SetAccountNumber manually sets the account number
+func (s *Signer) SetAccountNumber(n uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.accountNumber = n
+}
+
+// SetSequence manually sets the sequence
+func (s *Signer) SetSequence(n uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.sequence = n
+}
+
+// SetSignMode sets the sign mode used to sign transactions and share
+// commitments. Only SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON are
+// supported.
+func (s *Signer) SetSignMode(mode signing.SignMode) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.signMode = mode
+}
+
+// SetSignTimeout sets how long SignBytes waits for the RemoteSigner service to
+// sign bytes
+func (s *Signer) SetSignTimeout(timeout time.Duration) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.signTimeout = timeout
+}
@@ -0,0 +1,130 @@
+package remotesigner_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/client/remotesigner"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	keyringSigner := testutil.GenerateKeyringSigner(t, "test-account")
+
+	// run the keyring signer as a remote signing service
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	types.RegisterRemoteSignerServer(server, remotesigner.NewServer(keyringSigner))
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	remoteSigner, err := remotesigner.NewSigner(context.Background(), conn, encCfg, "test-chain-1")
+	require.NoError(t, err)
+	assert.Equal(t, keyringSigner.GetAddress(), remoteSigner.GetAddress())
+
+	keyringSigner.SetAccountNumber(3)
+	keyringSigner.SetSequence(2)
+	remoteSigner.SetAccountNumber(3)
+	remoteSigner.SetSequence(2)
+
+	// the share commitments are signed the same way by both signers
+	options := []types.TxBuilderOption{types.SetGasLimit(100000)}
+	signMsg := func(signer types.Signer) *types.MsgWirePayForMessage {
+		msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, []byte{1, 2, 3}, 4, 8)
+		require.NoError(t, err)
+		require.NoError(t, msg.SignShareCommitments(signer, options...))
+		return msg
+	}
+	assert.Equal(t, signMsg(keyringSigner), signMsg(remoteSigner))
+
+	// the tx signed by the remote signer is valid
+	msg := signMsg(remoteSigner)
+	tx, err := remoteSigner.BuildSignedTx(remoteSigner.NewTxBuilder(), msg)
+	require.NoError(t, err)
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	pubKey, err := remoteSigner.GetPubKey()
+	require.NoError(t, err)
+	signerData := authsigning.SignerData{ChainID: "test-chain-1", AccountNumber: 3, Sequence: 2}
+	err = authsigning.VerifySignature(pubKey, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), tx)
+	require.NoError(t, err)
+	assert.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, sigs[0].Data.(*signing.SingleSignatureData).SignMode)
+}
+
+// blockingServer is a RemoteSigner service that never responds to SignBytes
+// requests
+type blockingServer struct {
+	types.RemoteSignerServer
+}
+
+func (blockingServer) SignBytes(ctx context.Context, _ *types.SignBytesRequest) (*types.SignBytesResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestRemoteSignerTimeout(t *testing.T) {
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	keyringSigner := testutil.GenerateKeyringSigner(t, "test-account")
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	types.RegisterRemoteSignerServer(server, blockingServer{remotesigner.NewServer(keyringSigner)})
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	remoteSigner, err := remotesigner.NewSigner(context.Background(), conn, encCfg, "test-chain-1")
+	require.NoError(t, err)
+	remoteSigner.SetSignTimeout(100 * time.Millisecond)
+
+	_, err = remoteSigner.SignBytes([]byte{1, 2, 3})
+	require.Error(t, err)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// the caller can cancel the request before the timeout
+	remoteSigner.SetSignTimeout(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = remoteSigner.SignBytesContext(ctx, []byte{1, 2, 3})
+	require.Error(t, err)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), time.Minute)
+
+	// including when signing through helpers that only accept a types.Signer
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	msg, err := types.NewWirePayForMessage([]byte{1, 1, 1, 1, 1, 1, 1, 1}, []byte{1, 2, 3}, 4)
+	require.NoError(t, err)
+	err = msg.SignShareCommitments(remoteSigner.WithContext(ctx))
+	require.Error(t, err)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
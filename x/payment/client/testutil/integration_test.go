@@ -1,26 +1,42 @@
 package testutil
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
-	"github.com/tendermint/tendermint/pkg/consts"
+	"github.com/tendermint/spm/cosmoscmd"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	cosmosnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
 
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 
+	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/testutil/network"
+	"github.com/celestiaorg/celestia-app/x/payment/client/blob"
 	paycli "github.com/celestiaorg/celestia-app/x/payment/client/cli"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 // username is used to create a funded genesis account under this name
@@ -66,6 +82,9 @@ func (s *IntegrationTestSuite) TestSubmitWirePayForMessage() {
 	hexNS := "0102030405060708"
 	// some hex message
 	hexMsg := "0204033704032c0b162109000908094d425837422c2116"
+	rawMsg, err := hex.DecodeString(hexMsg)
+	s.Require().NoError(err)
+	msgFile := sdktestutil.WriteToNewTempFile(s.T(), string(rawMsg))
 
 	testCases := []struct {
 		name         string
@@ -99,6 +118,71 @@ func (s *IntegrationTestSuite) TestSubmitWirePayForMessage() {
 			},
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid transaction with estimated gas",
+			[]string{
+				hexNS,
+				hexMsg,
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, flags.GasFlagAuto),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid transaction with a message read from a file",
+			[]string{
+				hexNS,
+				fmt.Sprintf("--%s=%s", paycli.FlagFile, msgFile.Name()),
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid transaction with a base64 message and a padded namespace",
+			[]string{
+				"blob",
+				base64.StdEncoding.EncodeToString(rawMsg),
+				fmt.Sprintf("--%s=%s", paycli.FlagEncoding, paycli.EncodingBase64),
+				fmt.Sprintf("--%s=%s", paycli.FlagNamespaceEncoding, paycli.NamespaceEncodingPad),
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid transaction with a message provided twice",
+			[]string{
+				hexNS,
+				hexMsg,
+				fmt.Sprintf("--%s=%s", paycli.FlagFile, msgFile.Name()),
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			true, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid transaction signed using amino JSON",
+			[]string{
+				hexNS,
+				hexMsg,
+				fmt.Sprintf("--from=%s", username),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
 		{
 			"invalid transaction list of square sizes",
 			[]string{
@@ -142,7 +226,7 @@ func (s *IntegrationTestSuite) TestSubmitWirePayForMessage() {
 						require.NoError(err)
 						msgSize, err := strconv.ParseUint(e.GetAttributes()[1].GetValue(), 10, 64)
 						require.NoError(err)
-						s.Equal(uint64(0), msgSize%consts.ShareSize, "Message length should be multiples of const.ShareSize=%v", consts.ShareSize)
+						s.Equal(uint64(len(hexMsg)/2), msgSize, "Message length should be the length of the unpadded message")
 					}
 				}
 
@@ -161,6 +245,440 @@ func (s *IntegrationTestSuite) TestSubmitWirePayForMessage() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestPayForMessageDryRun() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	info, err := s.kr.Key(username)
+	require.NoError(err)
+	_, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, info.GetAddress())
+	require.NoError(err)
+
+	// the message is read from stdin
+	message := bytes.Repeat([]byte{1}, 1000)
+	cmd := paycli.CmdWirePayForMessage()
+	cmd.SetArgs([]string{
+		"my-namespace",
+		"-",
+		fmt.Sprintf("--%s=%s", paycli.FlagNamespaceEncoding, paycli.NamespaceEncodingHash),
+		fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "4,8"),
+		fmt.Sprintf("--%s=true", flags.FlagDryRun),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+	})
+	in, out := sdktestutil.ApplyMockIO(cmd)
+	in.Reset(string(message))
+	outCtx := clientCtx.WithOutput(out)
+	require.NoError(cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &outCtx)))
+
+	var res struct {
+		Namespace   string `json:"namespace"`
+		MessageSize uint64 `json:"message_size"`
+		Shares      uint64 `json:"shares"`
+		Commitments []struct {
+			SquareSize      uint64 `json:"square_size"`
+			ShareCommitment string `json:"share_commitment"`
+		} `json:"commitments"`
+		Fee        string `json:"fee"`
+		MessageFee string `json:"message_fee"`
+	}
+	require.NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+
+	nsHash := sha256.Sum256([]byte("my-namespace"))
+	namespace := nsHash[:types.NamespaceIDSize]
+	require.Equal(hex.EncodeToString(namespace), res.Namespace)
+	require.Equal(uint64(len(message)), res.MessageSize)
+	require.Equal(types.MessageShareCount(uint64(len(message))), res.Shares)
+	require.Len(res.Commitments, 2)
+	for i, k := range []uint64{4, 8} {
+		commit, err := types.CreateCommitment(k, namespace, message)
+		require.NoError(err)
+		require.Equal(k, res.Commitments[i].SquareSize)
+		require.Equal(hex.EncodeToString(commit), res.Commitments[i].ShareCommitment)
+	}
+	require.Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String(), res.Fee)
+	require.Equal(sdk.NewCoin(s.cfg.BondDenom, sdk.NewIntFromUint64(res.Shares)).String(), res.MessageFee)
+
+	// nothing is broadcasted
+	_, newSeq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, info.GetAddress())
+	require.NoError(err)
+	require.Equal(seq, newSeq)
+}
+
+func (s *IntegrationTestSuite) TestCommitmentCommands() {
+	require := s.Require()
+	val := s.network.Validators[0]
+
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	message := bytes.Repeat([]byte{2}, 2000)
+	hexNs, hexMsg := hex.EncodeToString(namespace), hex.EncodeToString(message)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdComputeCommitment(), []string{
+		hexNs,
+		hexMsg,
+		fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "4,8"),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	require.NoError(err)
+
+	var res struct {
+		Shares      uint64 `json:"shares"`
+		Commitments []struct {
+			SquareSize      uint64 `json:"square_size"`
+			ShareCommitment string `json:"share_commitment"`
+			Subtrees        []struct {
+				Width uint64 `json:"width"`
+				Root  string `json:"root"`
+			} `json:"subtrees"`
+		} `json:"commitments"`
+	}
+	require.NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+	require.Equal(types.MessageShareCount(uint64(len(message))), res.Shares)
+	require.Len(res.Commitments, 2)
+	for i, k := range []uint64{4, 8} {
+		commit, err := types.CreateCommitment(k, namespace, message)
+		require.NoError(err)
+		subtrees, err := types.CommitmentSubtrees(k, namespace, message)
+		require.NoError(err)
+
+		require.Equal(k, res.Commitments[i].SquareSize)
+		require.Equal(hex.EncodeToString(commit), res.Commitments[i].ShareCommitment)
+		require.Len(res.Commitments[i].Subtrees, len(subtrees))
+		for j, subtree := range subtrees {
+			require.Equal(subtree.Width, res.Commitments[i].Subtrees[j].Width)
+			require.Equal(hex.EncodeToString(subtree.Root), res.Commitments[i].Subtrees[j].Root)
+		}
+	}
+
+	// the commitment for square size 8 only matches that square size
+	commit, err := types.CreateCommitment(8, namespace, message)
+	require.NoError(err)
+
+	type test struct {
+		name       string
+		args       []string
+		expectErr  bool
+		squareSize uint64
+	}
+
+	tests := []test{
+		{
+			name: "matching commitment",
+			args: []string{
+				hexNs,
+				hexMsg,
+				fmt.Sprintf("--%s=%s", paycli.FlagCommitment, hex.EncodeToString(commit)),
+				fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "4,8"),
+			},
+			squareSize: 8,
+		},
+		{
+			name: "base64 commitment",
+			args: []string{
+				hexNs,
+				hexMsg,
+				fmt.Sprintf("--%s=%s", paycli.FlagCommitment, base64.StdEncoding.EncodeToString(commit)),
+				fmt.Sprintf("--%s=%s", paycli.FlagCommitmentEncoding, paycli.EncodingBase64),
+			},
+			squareSize: 8,
+		},
+		{
+			name: "square size not checked",
+			args: []string{
+				hexNs,
+				hexMsg,
+				fmt.Sprintf("--%s=%s", paycli.FlagCommitment, hex.EncodeToString(commit)),
+				fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "4"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "different message",
+			args: []string{
+				hexNs,
+				hex.EncodeToString(bytes.Repeat([]byte{3}, 2000)),
+				fmt.Sprintf("--%s=%s", paycli.FlagCommitment, hex.EncodeToString(commit)),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdVerifyCommitment(), args)
+			if tc.expectErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			var res struct {
+				Valid      bool   `json:"valid"`
+				SquareSize uint64 `json:"square_size"`
+			}
+			require.NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+			require.True(res.Valid)
+			require.Equal(tc.squareSize, res.SquareSize)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestSubmitPayForMessage() {
+	require := s.Require()
+	val := s.network.Validators[0]
+
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	require.NoError(err)
+	defer conn.Close()
+
+	signer := types.NewKeyringSigner(s.kr, username, s.cfg.ChainID)
+	require.NoError(signer.QueryAccountNumber(context.Background(), conn))
+
+	// build the MsgPayForMessage that the block producer would create
+	wireMsg, err := types.NewWirePayForMessage([]byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3}, 4)
+	require.NoError(err)
+	require.NoError(wireMsg.SignShareCommitments(signer))
+	_, pfm, _, err := types.ProcessWirePayForMessage(wireMsg, 4)
+	require.NoError(err)
+
+	builder := signer.NewTxBuilder()
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))))
+	builder.SetGasLimit(200000)
+	tx, err := signer.BuildSignedTx(builder, pfm)
+	require.NoError(err)
+	rawTx, err := signer.EncodeTx(tx)
+	require.NoError(err)
+
+	// a MsgPayForMessage that is submitted directly pays for a message that is
+	// never included in a block
+	res, err := types.BroadcastTx(context.Background(), conn, sdktx.BroadcastMode_BROADCAST_MODE_BLOCK, rawTx)
+	require.NoError(err)
+	require.Equal(types.ModuleName, res.TxResponse.Codespace, res.TxResponse.RawLog)
+	require.Equal(types.ErrPayForMessageNotMalleated.ABCICode(), res.TxResponse.Code, res.TxResponse.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestOfflineSignWire() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	info, err := s.kr.Key(username)
+	require.NoError(err)
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, info.GetAddress())
+	require.NoError(err)
+
+	// generate the unsigned wire tx without querying the node
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, paycli.CmdWirePayForMessage(), []string{
+		"0102030405060708",
+		"0204033704032c0b162109000908094d425837422c2116",
+		fmt.Sprintf("--from=%s", info.GetAddress()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "2,4"),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+	})
+	require.NoError(err)
+	unsignedFile := sdktestutil.WriteToNewTempFile(s.T(), out.String())
+
+	unsignedTx, err := authclient.ReadTxFromFile(clientCtx, unsignedFile.Name())
+	require.NoError(err)
+	unsignedMsg, ok := unsignedTx.GetMsgs()[0].(*types.MsgWirePayForMessage)
+	require.True(ok)
+	for _, commit := range unsignedMsg.MessageShareCommitment {
+		require.Empty(commit.Signature)
+	}
+
+	// sign the share commitments and the tx offline
+	signedFile := sdktestutil.WriteToNewTempFile(s.T(), "")
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, paycli.CmdSignWire(), []string{
+		unsignedFile.Name(),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, accNum),
+		fmt.Sprintf("--%s=%d", flags.FlagSequence, seq),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signedFile.Name()),
+	})
+	require.NoError(err)
+
+	signedTx, err := authclient.ReadTxFromFile(clientCtx, signedFile.Name())
+	require.NoError(err)
+	signedMsg, ok := signedTx.GetMsgs()[0].(*types.MsgWirePayForMessage)
+	require.True(ok)
+	for _, commit := range signedMsg.MessageShareCommitment {
+		require.NotEmpty(commit.Signature)
+	}
+
+	// the signed tx is broadcasted using the standard broadcast command
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcmd.GetBroadcastCommand(), []string{
+		signedFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	})
+	require.NoError(err)
+
+	var txResp sdk.TxResponse
+	require.NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	require.Equal(uint32(0), txResp.Code, txResp.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestSignWireSequenceFlag() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	info, err := s.kr.Key(username)
+	require.NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, paycli.CmdWirePayForMessage(), []string{
+		"0102030405060708",
+		"0204033704032c0b162109000908094d425837422c2116",
+		fmt.Sprintf("--from=%s", info.GetAddress()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", paycli.FlagSquareSizes, "2,4"),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+	})
+	require.NoError(err)
+	unsignedFile := sdktestutil.WriteToNewTempFile(s.T(), out.String())
+
+	// the sequence provided using the flag is not overwritten by the one
+	// queried from the node
+	signedFile := sdktestutil.WriteToNewTempFile(s.T(), "")
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, paycli.CmdSignWire(), []string{
+		unsignedFile.Name(),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%d", flags.FlagSequence, 1000),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signedFile.Name()),
+	})
+	require.NoError(err)
+
+	signedTx, err := authclient.ReadTxFromFile(clientCtx, signedFile.Name())
+	require.NoError(err)
+	sigTx, ok := signedTx.(authsigning.SigVerifiableTx)
+	require.True(ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(err)
+	require.Len(sigs, 1)
+	require.Equal(uint64(1000), sigs[0].Sequence)
+}
+
+func (s *IntegrationTestSuite) TestBlobClientSubmit() {
+	require := s.Require()
+	val := s.network.Validators[0]
+
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	require.NoError(err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	signer := types.NewKeyringSigner(s.kr, username, s.cfg.ChainID)
+	client, err := blob.NewClient(ctx, signer, conn, cosmoscmd.MakeEncodingConfig(app.ModuleBasics))
+	require.NoError(err)
+
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	data := bytes.Repeat([]byte{1}, 1000)
+	gasPrice := sdk.NewDecCoinFromDec(s.cfg.BondDenom, sdk.NewDecWithPrec(1, 5))
+	res, err := client.Submit(ctx, namespace, data, blob.WithGasPrice(gasPrice), blob.WithPollInterval(500*time.Millisecond))
+	require.NoError(err)
+
+	require.Greater(res.Height, int64(0))
+	require.NotEmpty(res.TxHash)
+	require.NotEmpty(res.ChildTxHash)
+	require.NotEqual(res.TxHash, res.ChildTxHash)
+
+	// the commitment and the share range match the blob
+	require.Len(res.Blobs, 1)
+	blobRes := res.Blobs[0]
+	commit, err := types.CreateCommitment(res.SquareSize, namespace, data)
+	require.NoError(err)
+	require.Equal(commit, blobRes.ShareCommitment)
+	require.Equal(types.MessageSharesUsed(len(data)), blobRes.EndShare-blobRes.StartShare)
+	require.LessOrEqual(blobRes.EndShare, res.SquareSize*res.SquareSize)
+
+	// the malleated tx is indexed under the hash of the original tx
+	txRes, err := sdktx.NewServiceClient(conn).GetTx(ctx, &sdktx.GetTxRequest{Hash: res.TxHash})
+	require.NoError(err)
+	require.Equal(res.Height, txRes.TxResponse.Height)
+
+	// several blobs are located in the order they were submitted
+	namespaces := [][]byte{{2, 2, 3, 4, 5, 6, 7, 8}, {1, 2, 3, 4, 5, 6, 7, 9}}
+	blobs := [][]byte{bytes.Repeat([]byte{2}, 600), bytes.Repeat([]byte{3}, 300)}
+	res, err = client.SubmitMany(ctx, namespaces, blobs, blob.WithGasPrice(gasPrice), blob.WithPollInterval(500*time.Millisecond))
+	require.NoError(err)
+	require.Len(res.Blobs, len(blobs))
+	for i, blobRes := range res.Blobs {
+		require.Equal(namespaces[i], blobRes.Namespace)
+		require.Equal(types.MessageSharesUsed(len(blobs[i])), blobRes.EndShare-blobRes.StartShare)
+	}
+	// the messages are sorted by namespace in the square
+	require.LessOrEqual(res.Blobs[1].EndShare, res.Blobs[0].StartShare)
+}
+
+func (s *IntegrationTestSuite) TestSignAndBroadcastTx() {
+	require := s.Require()
+	val := s.network.Validators[0]
+
+	conn, err := grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	require.NoError(err)
+	defer conn.Close()
+
+	signer := types.NewKeyringSigner(s.kr, username, s.cfg.ChainID)
+	require.NoError(signer.QueryAccountNumber(context.Background(), conn))
+	sequence := signer.GetSequence()
+
+	options := []types.TxBuilderOption{
+		types.SetGasLimit(200000),
+		types.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2)))),
+	}
+
+	// submit several txs concurrently without waiting for them to be
+	// included in a block
+	const txCount = 5
+	var wg sync.WaitGroup
+	responses := make([]*sdktx.BroadcastTxResponse, txCount)
+	errs := make([]error, txCount)
+	for i := 0; i < txCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			msg, err := types.NewWirePayForMessage([]byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{byte(i)}, 2, 4)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			responses[i], errs[i] = signer.SignAndBroadcastTx(context.Background(), conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, []sdk.Msg{msg}, options...)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range responses {
+		require.NoError(errs[i])
+		require.Equal(uint32(0), responses[i].TxResponse.Code, responses[i].TxResponse.RawLog)
+	}
+	require.Equal(sequence+txCount, signer.GetSequence())
+
+	// the signer resyncs its sequence after a tx is rejected because of it
+	signer.SetSequence(0)
+	msg, err := types.NewWirePayForMessage([]byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1}, 2, 4)
+	require.NoError(err)
+	res, err := signer.SignAndBroadcastTx(context.Background(), conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, []sdk.Msg{msg}, options...)
+	require.NoError(err)
+	require.Equal(uint32(0), res.TxResponse.Code, res.TxResponse.RawLog)
+	require.Equal(sequence+txCount+1, signer.GetSequence())
+
+	// every tx is included in a block
+	height, err := s.network.LatestHeight()
+	require.NoError(err)
+	_, err = s.network.WaitForHeight(height + 2)
+	require.NoError(err)
+	_, committedSequence, err := types.QueryAccount(context.Background(), conn, cosmoscmd.MakeEncodingConfig(app.ModuleBasics), signer.GetSignerInfo().GetAddress().String())
+	require.NoError(err)
+	require.Equal(sequence+txCount+1, committedSequence)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, NewIntegrationTestSuite(network.DefaultConfig()))
 }
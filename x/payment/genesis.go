@@ -9,12 +9,24 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the paidMessage
+	for _, paidMsg := range genState.PaidMessages {
+		k.SetPaidMessage(ctx, paidMsg)
+	}
+	// Set all the namespace
+	for _, ns := range genState.Namespaces {
+		k.SetNamespace(ctx, ns)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.PaidMessages = k.GetAllPaidMessages(ctx)
+	genesis.Namespaces = k.GetAllNamespaces(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
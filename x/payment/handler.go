@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	coretypes "github.com/tendermint/tendermint/types"
 )
 
 // NewHandler uses the provided payment keeper to create an sdk.Handler
@@ -17,11 +18,56 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
 		case *types.MsgPayForMessage:
+			if _, _, isMalleated := coretypes.UnwrapMalleatedTx(ctx.TxBytes()); !isMalleated {
+				return nil, types.ErrPayForMessageNotMalleated
+			}
 			res, err := msgServer.PayForMessage(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterNamespace:
+			res, err := msgServer.RegisterNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferNamespace:
+			res, err := msgServer.TransferNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateNamespaceWriters:
+			res, err := msgServer.UpdateNamespaceWriters(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnregisterNamespace:
+			res, err := msgServer.UnregisterNamespace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWirePayForMessage, *types.MsgWirePayForMessages:
+			// msgs are only executed in check mode when the tx is simulated
+			if !ctx.IsCheckTx() {
+				return nil, sdkerrors.Wrap(types.ErrUnmalleatedWireMsg, sdk.MsgTypeURL(msg))
+			}
+			res, err := simulateWireMsg(ctx, msgServer, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+// simulateWireMsg pays for the messages of a wire message as if it had been
+// malleated, so that the gas estimated for the wire message includes the gas
+// used by the MsgPayForMessages that it is malleated into
+func simulateWireMsg(ctx sdk.Context, msgServer types.MsgServer, msg sdk.Msg) (*types.MsgWirePayForMessageResponse, error) {
+	sizes := types.WireMsgSquareSizes(msg)
+	if len(sizes) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wire message does not commit to any square size")
+	}
+
+	_, pfms, _, err := types.ProcessWireMsg(msg, sizes[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, pfm := range pfms {
+		_, err = msgServer.PayForMessage(sdk.WrapSDKContext(ctx), pfm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgWirePayForMessageResponse{}, nil
+}
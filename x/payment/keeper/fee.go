@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MessageFee returns the fee charged for a message of the provided size
+func (k Keeper) MessageFee(ctx sdk.Context, size uint64) sdk.Coins {
+	shares := sdk.NewIntFromUint64(types.MessageShareCount(size))
+	return sdk.NewCoins(sdk.NewCoin(k.staking.BondDenom(ctx), shares.Mul(k.FeePerShare(ctx))))
+}
+
+// MsgMessageFee returns the fee charged for every message paid for by msg.
+// Msgs that don't pay for messages have no fee.
+func (k Keeper) MsgMessageFee(ctx sdk.Context, msg sdk.Msg) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, size := range types.MessageSizes(msg) {
+		fee = fee.Add(k.MessageFee(ctx, size)...)
+	}
+	return fee
+}
+
+// ChargeMessageFee moves the fee from the payer to the payment module account.
+// The burn ratio of the fee is burned, and the rest is paid to the proposer of
+// the current block. If the proposer cannot be found, the entire fee is burned.
+func (k Keeper) ChargeMessageFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	err := k.bank.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee)
+	if err != nil {
+		return err
+	}
+
+	burnRatio := k.BurnRatio(ctx)
+	burn := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := burnRatio.MulInt(coin.Amount).TruncateInt()
+		burn = burn.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	reward := fee.Sub(burn)
+
+	if !reward.IsZero() {
+		proposer, found := k.proposerAccount(ctx)
+		if found {
+			err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposer, reward)
+			if err != nil {
+				return err
+			}
+		} else {
+			burn = fee
+		}
+	}
+
+	if burn.IsZero() {
+		return nil
+	}
+	return k.bank.BurnCoins(ctx, types.ModuleName, burn)
+}
+
+// proposerAccount returns the operator account of the current block's proposer
+func (k Keeper) proposerAccount(ctx sdk.Context) (sdk.AccAddress, bool) {
+	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+	if consAddr.Empty() {
+		return nil, false
+	}
+	validator := k.staking.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(validator.GetOperator()), true
+}
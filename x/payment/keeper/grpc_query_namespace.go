@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Namespace returns a registered namespace
+func (k Keeper) Namespace(c context.Context, req *types.QueryNamespaceRequest) (*types.QueryNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ns, found := k.GetNamespace(ctx, req.NamespaceId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.NamespaceId)
+	}
+
+	return &types.QueryNamespaceResponse{Namespace: ns}, nil
+}
+
+// Namespaces returns the registered namespaces, optionally filtered by owner
+func (k Keeper) Namespaces(c context.Context, req *types.QueryNamespacesRequest) (*types.QueryNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var namespaces []types.Namespace
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	namespaceStore := prefix.NewStore(store, types.KeyPrefix(types.NamespaceKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(namespaceStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var ns types.Namespace
+		if err := k.cdc.Unmarshal(value, &ns); err != nil {
+			return false, err
+		}
+
+		if req.Owner != "" && ns.Owner != req.Owner {
+			return false, nil
+		}
+
+		if accumulate {
+			namespaces = append(namespaces, ns)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNamespacesResponse{Namespaces: namespaces, Pagination: pageRes}, nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/pkg/consts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PaidMessages returns the messages paid for in a namespace within the
+// requested range of heights
+func (k Keeper) PaidMessages(c context.Context, req *types.QueryPaidMessagesRequest) (*types.QueryPaidMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.NamespaceId) != consts.NamespaceSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace length: %d", len(req.NamespaceId))
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "heights cannot be negative: %d, %d", req.MinHeight, req.MaxHeight)
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "min height %d is greater than max height %d", req.MinHeight, req.MaxHeight)
+	}
+
+	var paidMsgs []types.PaidMessage
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := append(types.KeyPrefix(types.PaidMessageKeyPrefix), req.NamespaceId...)
+	paidMsgStore := prefix.NewStore(store, prefixKey)
+
+	// records are keyed by height within the namespace, so only the records
+	// of the requested heights are iterated over
+	heightStore := heightRangeStore{
+		KVStore: paidMsgStore,
+		start:   sdk.Uint64ToBigEndian(uint64(req.MinHeight)),
+	}
+	if req.MaxHeight != 0 {
+		heightStore.end = sdk.Uint64ToBigEndian(uint64(req.MaxHeight) + 1)
+	}
+
+	pageRes, err := query.Paginate(heightStore, req.Pagination, func(key []byte, value []byte) error {
+		var paidMsg types.PaidMessage
+		if err := k.cdc.Unmarshal(value, &paidMsg); err != nil {
+			return err
+		}
+
+		paidMsgs = append(paidMsgs, paidMsg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaidMessagesResponse{PaidMessages: paidMsgs, Pagination: pageRes}, nil
+}
+
+// AllPaidMessages returns the messages paid for in every namespace
+func (k Keeper) AllPaidMessages(c context.Context, req *types.QueryAllPaidMessagesRequest) (*types.QueryAllPaidMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var paidMsgs []types.PaidMessage
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	paidMsgStore := prefix.NewStore(store, types.KeyPrefix(types.PaidMessageKeyPrefix))
+
+	pageRes, err := query.Paginate(paidMsgStore, req.Pagination, func(key []byte, value []byte) error {
+		var paidMsg types.PaidMessage
+		if err := k.cdc.Unmarshal(value, &paidMsg); err != nil {
+			return err
+		}
+
+		paidMsgs = append(paidMsgs, paidMsg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPaidMessagesResponse{PaidMessages: paidMsgs, Pagination: pageRes}, nil
+}
+
+// heightRangeStore limits the iterators of a store to the keys in [start, end),
+// so that query.Paginate only goes over the records of a range of heights. A
+// nil end iterates to the end of the store.
+type heightRangeStore struct {
+	sdk.KVStore
+	start, end []byte
+}
+
+func (s heightRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(s.clamp(start, end))
+}
+
+func (s heightRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(s.clamp(start, end))
+}
+
+func (s heightRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// an empty range is left when the iteration starts past the range
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
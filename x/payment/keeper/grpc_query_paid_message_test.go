@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestPaidMessagesQuery(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	k := testApp.PaymentKeeper
+
+	nsA := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	nsB := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+
+	// pay for a message in namespace A at heights 1 through 4 and one message
+	// in namespace B at height 2
+	for height := int64(1); height <= 4; height++ {
+		ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: height}).WithTxBytes([]byte{byte(height)})
+		_, err := k.PayForMessage(sdk.WrapSDKContext(ctx), &types.MsgPayForMessage{
+			Signer:                 signerAddr.String(),
+			MessageNamespaceId:     nsA,
+			MessageSize:            types.ShareSize,
+			MessageShareCommitment: []byte{byte(height)},
+		})
+		require.NoError(t, err)
+		if height == 2 {
+			_, err = k.PayForMessage(sdk.WrapSDKContext(ctx), &types.MsgPayForMessage{
+				Signer:             signerAddr.String(),
+				MessageNamespaceId: nsB,
+				MessageSize:        types.ShareSize,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	ctx := sdk.WrapSDKContext(testApp.BaseApp.NewContext(false, tmproto.Header{}))
+
+	type test struct {
+		name            string
+		req             *types.QueryPaidMessagesRequest
+		expectedHeights []int64
+		expectErr       bool
+	}
+
+	tests := []test{
+		{
+			name:            "whole namespace",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsA},
+			expectedHeights: []int64{1, 2, 3, 4},
+		},
+		{
+			name:            "height range",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 2, MaxHeight: 3},
+			expectedHeights: []int64{2, 3},
+		},
+		{
+			name:            "min height only",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 4},
+			expectedHeights: []int64{4},
+		},
+		{
+			name:            "paginated",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 2, Pagination: &query.PageRequest{Limit: 2}},
+			expectedHeights: []int64{2, 3},
+		},
+		{
+			name:            "reversed",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 2, MaxHeight: 4, Pagination: &query.PageRequest{Reverse: true}},
+			expectedHeights: []int64{4, 3, 2},
+		},
+		{
+			name:            "other namespace",
+			req:             &types.QueryPaidMessagesRequest{NamespaceId: nsB},
+			expectedHeights: []int64{2},
+		},
+		{
+			name:      "invalid namespace",
+			req:       &types.QueryPaidMessagesRequest{NamespaceId: []byte{1}},
+			expectErr: true,
+		},
+		{
+			name:      "negative height",
+			req:       &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: -1},
+			expectErr: true,
+		},
+		{
+			name:      "invalid height range",
+			req:       &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 3, MaxHeight: 2},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		res, err := k.PaidMessages(ctx, tt.req)
+		if tt.expectErr {
+			assert.Error(t, err, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		heights := make([]int64, len(res.PaidMessages))
+		for i, paidMsg := range res.PaidMessages {
+			heights[i] = paidMsg.Height
+			assert.Equal(t, tt.req.NamespaceId, paidMsg.NamespaceId, tt.name)
+			assert.Equal(t, signerAddr.String(), paidMsg.Signer, tt.name)
+		}
+		assert.Equal(t, tt.expectedHeights, heights, tt.name)
+	}
+
+	// the next key resumes within the height range
+	req := &types.QueryPaidMessagesRequest{NamespaceId: nsA, MinHeight: 2, MaxHeight: 3, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}}
+	page, err := k.PaidMessages(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, page.PaidMessages, 1)
+	assert.Equal(t, int64(2), page.PaidMessages[0].Height)
+	assert.Equal(t, uint64(2), page.Pagination.Total)
+	require.NotNil(t, page.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1}
+	page, err = k.PaidMessages(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, page.PaidMessages, 1)
+	assert.Equal(t, int64(3), page.PaidMessages[0].Height)
+	assert.Nil(t, page.Pagination.NextKey)
+
+	res, err := k.AllPaidMessages(ctx, &types.QueryAllPaidMessagesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, res.PaidMessages, 5)
+}
+
+func TestPaidMessagesIdenticalMessages(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	k := testApp.PaymentKeeper
+
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	msg := &types.MsgPayForMessage{
+		Signer:                 signerAddr.String(),
+		MessageNamespaceId:     ns,
+		MessageSize:            types.ShareSize,
+		MessageShareCommitment: []byte{1},
+	}
+
+	// the same message is paid for twice by a single transaction
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1}).WithTxBytes([]byte{1})
+	for i := 0; i < 2; i++ {
+		_, err := k.PayForMessage(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	res, err := k.PaidMessages(sdk.WrapSDKContext(ctx), &types.QueryPaidMessagesRequest{NamespaceId: ns})
+	require.NoError(t, err)
+	require.Len(t, res.PaidMessages, 2)
+	assert.Equal(t, uint32(0), res.PaidMessages[0].MessageIndex)
+	assert.Equal(t, uint32(1), res.PaidMessages[1].MessageIndex)
+}
+
+func TestPrunePaidMessages(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	k := testApp.PaymentKeeper
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	params := k.GetParams(ctx)
+	params.PaidMessageRetention = 2
+	k.SetParams(ctx, params)
+
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	heights := func() []int64 {
+		var heights []int64
+		for _, paidMsg := range k.GetAllPaidMessages(ctx) {
+			heights = append(heights, paidMsg.Height)
+		}
+		return heights
+	}
+
+	for height := int64(1); height <= 4; height++ {
+		ctx = ctx.WithBlockHeight(height).WithTxBytes([]byte{byte(height)})
+		_, err := k.PayForMessage(sdk.WrapSDKContext(ctx), &types.MsgPayForMessage{
+			Signer:             signerAddr.String(),
+			MessageNamespaceId: ns,
+			MessageSize:        types.ShareSize,
+		})
+		require.NoError(t, err)
+		k.PrunePaidMessages(ctx)
+	}
+	assert.Equal(t, []int64{3, 4}, heights())
+
+	// lowering the retention prunes the older records
+	params.PaidMessageRetention = 1
+	k.SetParams(ctx, params)
+	k.PrunePaidMessages(ctx)
+	assert.Equal(t, []int64{4}, heights())
+
+	// a retention of zero keeps the records forever
+	params.PaidMessageRetention = 0
+	k.SetParams(ctx, params)
+	k.PrunePaidMessages(ctx.WithBlockHeight(100))
+	assert.Equal(t, []int64{4}, heights())
+}
@@ -9,22 +9,41 @@ import (
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper handles all the state changes for the celestia-app module.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey sdk.StoreKey
-	memKey   sdk.StoreKey
-	bank     BankKeeper
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	memKey     sdk.StoreKey
+	paramstore paramtypes.Subspace
+	bank       BankKeeper
+	staking    StakingKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, bank BankKeeper, storeKey, memKey sdk.StoreKey) *Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	bank BankKeeper,
+	staking StakingKeeper,
+	storeKey,
+	memKey sdk.StoreKey,
+	ps paramtypes.Subspace,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		memKey:   memKey,
-		bank:     bank,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		memKey:     memKey,
+		paramstore: ps,
+		bank:       bank,
+		staking:    staking,
 	}
 }
 
@@ -32,11 +51,29 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-//  MsgPayForMessage moves a user's coins to the module address and burns them.
+// PayForMessage stores a record of the paid message so that it can be queried
+// by namespace. Only the owner and writers can pay for messages in a
+// registered namespace. The fee for each share of the message is charged by
+// the MessageFeeDecorator before the msg is executed, so that a signer that
+// can't pay for the shares is never included in a block.
 func (k Keeper) PayForMessage(goCtx context.Context, msg *types.MsgPayForMessage) (*types.MsgPayForMessageResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.CanWrite(ctx, msg.MessageNamespaceId, msg.Signer) {
+		return nil, sdkerrors.Wrapf(types.ErrNamespaceUnauthorized, "%X", msg.MessageNamespaceId)
+	}
+
+	fee := k.MessageFee(ctx, msg.MessageSize)
+
+	k.recordPaidMessage(ctx, msg)
+
 	ctx.EventManager().EmitEvent(
-		types.NewPayForMessageEvent(sdk.AccAddress(msg.Signer).String(), msg.GetMessageSize()),
+		types.NewPayForMessageEvent(signer.String(), msg.GetMessageSize(), fee),
 	)
 
 	return &types.MsgPayForMessageResponse{}, nil
@@ -45,5 +82,13 @@ func (k Keeper) PayForMessage(goCtx context.Context, msg *types.MsgPayForMessage
 // BankKeeper restricts the funtionality of the bank keeper used in the payment keeper
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// StakingKeeper restricts the functionality of the staking keeper used in the
+// payment keeper
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	ValidatorByConsAddr(ctx sdk.Context, addr sdk.ConsAddress) stakingtypes.ValidatorI
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestChargeMessageFee(t *testing.T) {
+	type test struct {
+		name         string
+		feePerShare  sdk.Int
+		burnRatio    sdk.Dec
+		size         uint64
+		hasProposer  bool
+		expectedBurn int64
+		expectedPaid int64
+	}
+
+	tests := []test{
+		{
+			name:         "single share split with the proposer",
+			feePerShare:  sdk.NewInt(10),
+			burnRatio:    sdk.NewDecWithPrec(5, 1),
+			size:         1,
+			hasProposer:  true,
+			expectedBurn: 5,
+			expectedPaid: 5,
+		},
+		{
+			name:         "multiple shares completely burned",
+			feePerShare:  sdk.NewInt(10),
+			burnRatio:    sdk.OneDec(),
+			size:         3*types.ShareSize + 1,
+			hasProposer:  true,
+			expectedBurn: 40,
+			expectedPaid: 0,
+		},
+		{
+			name:         "burn everything without a proposer",
+			feePerShare:  sdk.NewInt(7),
+			burnRatio:    sdk.NewDecWithPrec(25, 2),
+			size:         300,
+			hasProposer:  false,
+			expectedBurn: 14,
+			expectedPaid: 0,
+		},
+		{
+			name:         "empty message uses a single share",
+			feePerShare:  sdk.NewInt(10),
+			burnRatio:    sdk.ZeroDec(),
+			size:         0,
+			hasProposer:  true,
+			expectedBurn: 0,
+			expectedPaid: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		signer := testutil.GenerateKeyringSigner(t, testAccName)
+		signerAddr := signer.GetSignerInfo().GetAddress()
+		testApp := testutil.SetupTestApp(t, signerAddr)
+
+		header := tmproto.Header{}
+		proposerPubKey := ed25519.GenPrivKey().PubKey()
+		proposer := sdk.AccAddress(proposerPubKey.Address())
+		if tt.hasProposer {
+			header.ProposerAddress = proposerPubKey.Address()
+		}
+		ctx := testApp.BaseApp.NewContext(false, header)
+
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(proposer), proposerPubKey, stakingtypes.Description{})
+		require.NoError(t, err, tt.name)
+		testApp.StakingKeeper.SetValidator(ctx, validator)
+		err = testApp.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+		require.NoError(t, err, tt.name)
+
+		k := testApp.PaymentKeeper
+		params := types.DefaultParams()
+		params.FeePerShare = tt.feePerShare
+		params.BurnRatio = tt.burnRatio
+		k.SetParams(ctx, params)
+
+		signerBalance := testApp.BankKeeper.GetBalance(ctx, signerAddr, app.BondDenom)
+		supply := testApp.BankKeeper.GetSupply(ctx, app.BondDenom)
+
+		err = k.ChargeMessageFee(ctx, signerAddr, k.MessageFee(ctx, tt.size))
+		require.NoError(t, err, tt.name)
+
+		expectedFee := tt.expectedBurn + tt.expectedPaid
+		assert.Equal(t,
+			signerBalance.Amount.SubRaw(expectedFee),
+			testApp.BankKeeper.GetBalance(ctx, signerAddr, app.BondDenom).Amount,
+			tt.name,
+		)
+		assert.Equal(t,
+			supply.Amount.SubRaw(tt.expectedBurn),
+			testApp.BankKeeper.GetSupply(ctx, app.BondDenom).Amount,
+			tt.name,
+		)
+		assert.Equal(t,
+			sdk.NewInt(tt.expectedPaid),
+			testApp.BankKeeper.GetBalance(ctx, proposer, app.BondDenom).Amount,
+			tt.name,
+		)
+		moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+		assert.True(t, testApp.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero(), tt.name)
+	}
+}
+
+func TestChargeMessageFeeInsufficientFunds(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	signerAddr := signer.GetSignerInfo().GetAddress()
+	testApp := testutil.SetupTestApp(t, signerAddr)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	k := testApp.PaymentKeeper
+	params := types.DefaultParams()
+	params.FeePerShare = sdk.NewInt(1000000000)
+	params.BurnRatio = sdk.OneDec()
+	k.SetParams(ctx, params)
+
+	err := k.ChargeMessageFee(ctx, signerAddr, k.MessageFee(ctx, types.ShareSize))
+	assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+const testAccName = "test-account"
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterNamespace registers a namespace to the owner, escrowing the
+// namespace deposit in the payment module account
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if bytes.Compare(msg.NamespaceId, k.MaxReservedNamespace(ctx)) < 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot register reserved namespace %X", msg.NamespaceId)
+	}
+
+	if _, found := k.GetNamespace(ctx, msg.NamespaceId); found {
+		return nil, sdkerrors.Wrapf(types.ErrNamespaceAlreadyRegistered, "%X", msg.NamespaceId)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := sdk.NewCoins(sdk.NewCoin(k.staking.BondDenom(ctx), k.NamespaceDeposit(ctx)))
+	if !deposit.IsZero() {
+		err = k.bank.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, deposit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetNamespace(ctx, types.Namespace{
+		NamespaceId: msg.NamespaceId,
+		Owner:       msg.Owner,
+		Deposit:     deposit,
+	})
+
+	ctx.EventManager().EmitEvent(
+		types.NewRegisterNamespaceEvent(msg.Owner, msg.NamespaceId),
+	)
+
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// TransferNamespace transfers the ownership of a registered namespace
+func (k msgServer) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ns, err := k.ownedNamespace(ctx, msg.NamespaceId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ns.Owner = msg.NewOwner
+	k.SetNamespace(ctx, ns)
+
+	ctx.EventManager().EmitEvent(
+		types.NewTransferNamespaceEvent(msg.Owner, msg.NamespaceId, msg.NewOwner),
+	)
+
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// UpdateNamespaceWriters replaces the writers of a registered namespace
+func (k msgServer) UpdateNamespaceWriters(goCtx context.Context, msg *types.MsgUpdateNamespaceWriters) (*types.MsgUpdateNamespaceWritersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ns, err := k.ownedNamespace(ctx, msg.NamespaceId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ns.Writers = msg.Writers
+	k.SetNamespace(ctx, ns)
+
+	ctx.EventManager().EmitEvent(
+		types.NewUpdateNamespaceWritersEvent(msg.Owner, msg.NamespaceId, msg.Writers),
+	)
+
+	return &types.MsgUpdateNamespaceWritersResponse{}, nil
+}
+
+// UnregisterNamespace releases a registered namespace, refunding the deposit
+// escrowed in the payment module account to the current owner
+func (k msgServer) UnregisterNamespace(goCtx context.Context, msg *types.MsgUnregisterNamespace) (*types.MsgUnregisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ns, err := k.ownedNamespace(ctx, msg.NamespaceId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(ns.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if !ns.Deposit.IsZero() {
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, ns.Deposit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.RemoveNamespace(ctx, msg.NamespaceId)
+
+	ctx.EventManager().EmitEvent(
+		types.NewUnregisterNamespaceEvent(msg.Owner, msg.NamespaceId, ns.Deposit),
+	)
+
+	return &types.MsgUnregisterNamespaceResponse{}, nil
+}
+
+// ownedNamespace returns the registered namespace if it is owned by owner
+func (k Keeper) ownedNamespace(ctx sdk.Context, namespace []byte, owner string) (types.Namespace, error) {
+	ns, found := k.GetNamespace(ctx, namespace)
+	if !found {
+		return ns, sdkerrors.Wrapf(types.ErrNamespaceNotFound, "%X", namespace)
+	}
+	if ns.Owner != owner {
+		return ns, sdkerrors.Wrapf(types.ErrNotNamespaceOwner, "%X is owned by %s", namespace, ns.Owner)
+	}
+	return ns, nil
+}
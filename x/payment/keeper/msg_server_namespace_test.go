@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/payment/keeper"
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestNamespaceRegistry(t *testing.T) {
+	signer := testutil.GenerateKeyringSigner(t, testAccName)
+	ownerAddr := signer.GetSignerInfo().GetAddress()
+	owner := ownerAddr.String()
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	testApp := testutil.SetupTestApp(t, ownerAddr)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := testApp.PaymentKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// messages are free, so that the other account doesn't need any funds
+	params := k.GetParams(ctx)
+	params.FeePerShare = sdk.ZeroInt()
+	k.SetParams(ctx, params)
+
+	ns := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	payForMessage := func(signer string) error {
+		_, err := k.PayForMessage(goCtx, &types.MsgPayForMessage{
+			Signer:             signer,
+			MessageNamespaceId: ns,
+		})
+		return err
+	}
+
+	// anyone can pay for messages in an unregistered namespace
+	require.NoError(t, payForMessage(other))
+
+	// registering escrows the deposit in the module account
+	balance := testApp.BankKeeper.GetBalance(ctx, ownerAddr, app.BondDenom)
+	_, err := msgServer.RegisterNamespace(goCtx, types.NewMsgRegisterNamespace(owner, ns))
+	require.NoError(t, err)
+	deposit := sdk.NewCoin(app.BondDenom, types.DefaultNamespaceDeposit)
+	assert.Equal(t, balance.Sub(deposit), testApp.BankKeeper.GetBalance(ctx, ownerAddr, app.BondDenom))
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	assert.Equal(t, deposit, testApp.BankKeeper.GetBalance(ctx, moduleAddr, app.BondDenom))
+
+	_, err = msgServer.RegisterNamespace(goCtx, types.NewMsgRegisterNamespace(other, ns))
+	assert.ErrorIs(t, err, types.ErrNamespaceAlreadyRegistered)
+
+	// only the owner can pay for messages in a newly registered namespace
+	assert.NoError(t, payForMessage(owner))
+	assert.ErrorIs(t, payForMessage(other), types.ErrNamespaceUnauthorized)
+
+	// writers can pay for messages
+	_, err = msgServer.UpdateNamespaceWriters(goCtx, types.NewMsgUpdateNamespaceWriters(other, ns, []string{other}))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = msgServer.UpdateNamespaceWriters(goCtx, types.NewMsgUpdateNamespaceWriters(owner, ns, []string{other}))
+	require.NoError(t, err)
+	assert.NoError(t, payForMessage(other))
+
+	// the new owner controls the namespace after a transfer
+	_, err = msgServer.TransferNamespace(goCtx, types.NewMsgTransferNamespace(owner, ns, other))
+	require.NoError(t, err)
+	_, err = msgServer.UpdateNamespaceWriters(goCtx, types.NewMsgUpdateNamespaceWriters(owner, ns, nil))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = msgServer.UpdateNamespaceWriters(goCtx, types.NewMsgUpdateNamespaceWriters(other, ns, nil))
+	require.NoError(t, err)
+	assert.ErrorIs(t, payForMessage(owner), types.ErrNamespaceUnauthorized)
+
+	res, err := k.Namespaces(goCtx, &types.QueryNamespacesRequest{Owner: other})
+	require.NoError(t, err)
+	require.Len(t, res.Namespaces, 1)
+	assert.Equal(t, ns, res.Namespaces[0].NamespaceId)
+	assert.Equal(t, sdk.NewCoins(deposit), res.Namespaces[0].Deposit)
+
+	res, err = k.Namespaces(goCtx, &types.QueryNamespacesRequest{Owner: owner})
+	require.NoError(t, err)
+	assert.Empty(t, res.Namespaces)
+
+	// unregistering refunds the deposit to the current owner
+	_, err = msgServer.UnregisterNamespace(goCtx, types.NewMsgUnregisterNamespace(owner, ns))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	otherAddr, err := sdk.AccAddressFromBech32(other)
+	require.NoError(t, err)
+	_, err = msgServer.UnregisterNamespace(goCtx, types.NewMsgUnregisterNamespace(other, ns))
+	require.NoError(t, err)
+	assert.Equal(t, deposit, testApp.BankKeeper.GetBalance(ctx, otherAddr, app.BondDenom))
+	assert.True(t, testApp.BankKeeper.GetBalance(ctx, moduleAddr, app.BondDenom).IsZero())
+
+	// anyone can pay for messages in the namespace again
+	_, found := k.GetNamespace(ctx, ns)
+	assert.False(t, found)
+	assert.NoError(t, payForMessage(owner))
+	_, err = msgServer.UnregisterNamespace(goCtx, types.NewMsgUnregisterNamespace(other, ns))
+	assert.ErrorIs(t, err, types.ErrNamespaceNotFound)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetNamespace stores a registered namespace
+func (k Keeper) SetNamespace(ctx sdk.Context, ns types.Namespace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKeyPrefix))
+	store.Set(ns.NamespaceId, k.cdc.MustMarshal(&ns))
+}
+
+// RemoveNamespace removes a registered namespace
+func (k Keeper) RemoveNamespace(ctx sdk.Context, namespace []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKeyPrefix))
+	store.Delete(namespace)
+}
+
+// GetNamespace returns a registered namespace from its ID
+func (k Keeper) GetNamespace(ctx sdk.Context, namespace []byte) (val types.Namespace, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKeyPrefix))
+
+	b := store.Get(namespace)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllNamespaces returns every registered namespace
+func (k Keeper) GetAllNamespaces(ctx sdk.Context) (list []types.Namespace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Namespace
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CanWrite returns true if the signer is allowed to pay for messages in the
+// namespace. Anyone can pay for messages in a namespace that is not
+// registered.
+func (k Keeper) CanWrite(ctx sdk.Context, namespace []byte, signer string) bool {
+	ns, found := k.GetNamespace(ctx, namespace)
+	if !found {
+		return true
+	}
+	return ns.CanWrite(signer)
+}
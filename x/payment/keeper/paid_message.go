@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// SetPaidMessage stores the record of a paid message and indexes it by height
+func (k Keeper) SetPaidMessage(ctx sdk.Context, paidMsg types.PaidMessage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaidMessageKeyPrefix))
+	key := types.PaidMessageKey(paidMsg.NamespaceId, paidMsg.Height, paidMsg.TxHash, paidMsg.MessageIndex, paidMsg.ShareCommitment)
+	store.Set(key, k.cdc.MustMarshal(&paidMsg))
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaidMessageHeightKeyPrefix))
+	heightKey := types.PaidMessageHeightKey(paidMsg.NamespaceId, paidMsg.Height, paidMsg.TxHash, paidMsg.MessageIndex, paidMsg.ShareCommitment)
+	heightStore.Set(heightKey, []byte{})
+}
+
+// PrunePaidMessages deletes the records of the messages that were paid for
+// more than PaidMessageRetention blocks ago. Nothing is deleted if the param
+// is zero.
+func (k Keeper) PrunePaidMessages(ctx sdk.Context) {
+	retention := k.PaidMessageRetention(ctx)
+	if retention == 0 || ctx.BlockHeight() <= int64(retention) {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaidMessageKeyPrefix))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaidMessageHeightKeyPrefix))
+
+	// the records are deleted after iterating, as the store can't be written
+	// to while it is iterated over
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) - retention + 1)
+	iterator := heightStore.Iterator(nil, end)
+	var heightKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		heightKeys = append(heightKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, heightKey := range heightKeys {
+		store.Delete(types.PaidMessageKeyFromHeightKey(heightKey))
+		heightStore.Delete(heightKey)
+	}
+}
+
+// GetAllPaidMessages returns the records of every paid message
+func (k Keeper) GetAllPaidMessages(ctx sdk.Context) (list []types.PaidMessage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaidMessageKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PaidMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordPaidMessage stores the record of a MsgPayForMessage that was executed
+// in the current transaction
+func (k Keeper) recordPaidMessage(ctx sdk.Context, msg *types.MsgPayForMessage) {
+	hash := txHash(ctx.TxBytes())
+	k.SetPaidMessage(ctx, types.PaidMessage{
+		NamespaceId:     msg.MessageNamespaceId,
+		Signer:          msg.Signer,
+		MessageSize:     msg.MessageSize,
+		ShareCommitment: msg.MessageShareCommitment,
+		Height:          ctx.BlockHeight(),
+		TxHash:          hash,
+		MessageIndex:    k.nextMessageIndex(ctx, msg.MessageNamespaceId, ctx.BlockHeight(), hash),
+	})
+}
+
+// nextMessageIndex returns the index following the one of the last message
+// recorded for the transaction in the namespace
+func (k Keeper) nextMessageIndex(ctx sdk.Context, namespace []byte, height int64, txHash []byte) uint32 {
+	txPrefix := append(types.KeyPrefix(types.PaidMessageKeyPrefix), types.PaidMessageTxPrefix(namespace, height, txHash)...)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), txPrefix)
+
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint32(iterator.Key()[:4]) + 1
+}
+
+// txHash returns the hash that the transaction is indexed under by
+// celestia-core. Malleated transactions are indexed using the hash of the
+// original transaction.
+func txHash(txBytes []byte) []byte {
+	if originalHash, _, isMalleated := coretypes.UnwrapMalleatedTx(txBytes); isMalleated {
+		return originalHash
+	}
+	return tmhash.Sum(txBytes)
+}
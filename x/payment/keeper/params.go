@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.MaxMessageBytes(ctx),
+		k.FeePerShare(ctx),
+		k.BurnRatio(ctx),
+		k.SquareSizes(ctx),
+		k.MaxReservedNamespace(ctx),
+		k.NamespaceDeposit(ctx),
+		k.GasPerMessageByte(ctx),
+		k.GasPerCommitment(ctx),
+		k.PaidMessageRetention(ctx),
+	)
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// MaxMessageBytes returns the MaxMessageBytes param
+func (k Keeper) MaxMessageBytes(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMessageBytes, &res)
+	return
+}
+
+// FeePerShare returns the FeePerShare param
+func (k Keeper) FeePerShare(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyFeePerShare, &res)
+	return
+}
+
+// BurnRatio returns the BurnRatio param
+func (k Keeper) BurnRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyBurnRatio, &res)
+	return
+}
+
+// SquareSizes returns the SquareSizes param
+func (k Keeper) SquareSizes(ctx sdk.Context) (res []uint64) {
+	k.paramstore.Get(ctx, types.KeySquareSizes, &res)
+	return
+}
+
+// MaxReservedNamespace returns the MaxReservedNamespace param
+func (k Keeper) MaxReservedNamespace(ctx sdk.Context) (res []byte) {
+	k.paramstore.Get(ctx, types.KeyMaxReservedNamespace, &res)
+	return
+}
+
+// NamespaceDeposit returns the NamespaceDeposit param
+func (k Keeper) NamespaceDeposit(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyNamespaceDeposit, &res)
+	return
+}
+
+// GasPerMessageByte returns the GasPerMessageByte param
+func (k Keeper) GasPerMessageByte(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyGasPerMessageByte, &res)
+	return
+}
+
+// GasPerCommitment returns the GasPerCommitment param
+func (k Keeper) GasPerCommitment(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyGasPerCommitment, &res)
+	return
+}
+
+// PaidMessageRetention returns the PaidMessageRetention param
+func (k Keeper) PaidMessageRetention(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPaidMessageRetention, &res)
+	return
+}
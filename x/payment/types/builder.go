@@ -2,16 +2,23 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
 	"sync"
+	"time"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/tendermint/spm/cosmoscmd"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc"
 )
 
@@ -22,9 +29,15 @@ type KeyringSigner struct {
 	accountNumber  uint64
 	sequence       uint64
 	chainID        string
+	signMode       signing.SignMode
 	encCfg         cosmoscmd.EncodingConfig
 
 	sync.RWMutex
+
+	// submitMtx serializes the reservation of a sequence and the signing of
+	// the txs submitted using SignAndBroadcastTx. It is not held while the txs
+	// are broadcasted.
+	submitMtx sync.Mutex
 }
 
 // NewKeyringSigner returns a new KeyringSigner using the provided keyring
@@ -33,6 +46,7 @@ func NewKeyringSigner(ring keyring.Keyring, name string, chainID string) *Keyrin
 		Keyring:        ring,
 		keyringAccName: name,
 		chainID:        chainID,
+		signMode:       signing.SignMode_SIGN_MODE_DIRECT,
 		encCfg:         makeEncodingConfig(),
 	}
 }
@@ -64,98 +78,320 @@ func (k *KeyringSigner) NewTxBuilder() sdkclient.TxBuilder {
 	return k.encCfg.TxConfig.NewTxBuilder()
 }
 
-// BuildSignedTx creates and signs a sdk.Tx that contains the provided message. The interal
+// BuildSignedTx creates and signs a sdk.Tx that contains the provided messages. The interal
 // account number must be set by calling k.QueryAccountNumber or by manually setting it via
-// k.SetAccountNumber for the built transactions to be valid.
-func (k *KeyringSigner) BuildSignedTx(builder sdkclient.TxBuilder, msg sdktypes.Msg) (authsigning.Tx, error) {
+// k.SetAccountNumber for the built transactions to be valid. The transaction is signed using
+// the sign mode set via k.SetSignMode, which defaults to SIGN_MODE_DIRECT.
+func (k *KeyringSigner) BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdktypes.Msg) (authsigning.Tx, error) {
+	return k.buildSignedTx(builder, k.GetSequence(), msgs...)
+}
+
+// buildSignedTx creates and signs a sdk.Tx that contains the provided messages
+// using the provided sequence
+func (k *KeyringSigner) buildSignedTx(builder sdkclient.TxBuilder, sequence uint64, msgs ...sdktypes.Msg) (authsigning.Tx, error) {
 	k.RLock()
-	accountNumber := k.accountNumber
-	sequence := k.sequence
+	signerData := authsigning.SignerData{
+		ChainID:       k.chainID,
+		AccountNumber: k.accountNumber,
+		Sequence:      sequence,
+	}
+	signMode := k.signMode
 	k.RUnlock()
 
-	// set the msg
-	err := builder.SetMsgs(msg)
+	return SignTx(k, k.encCfg.TxConfig, signMode, signerData, builder, msgs...)
+}
+
+// GetAddress returns the address of the KeyringSigner's account. panics if
+// the account in KeyringSigner does not exist.
+func (k *KeyringSigner) GetAddress() sdktypes.AccAddress {
+	return k.GetSignerInfo().GetAddress()
+}
+
+// GetPubKey returns the public key of the KeyringSigner's account
+func (k *KeyringSigner) GetPubKey() (cryptotypes.PubKey, error) {
+	info, err := k.Key(k.keyringAccName)
 	if err != nil {
 		return nil, err
 	}
+	return info.GetPubKey(), nil
+}
+
+// SignBytes signs the provided bytes using the key of the KeyringSigner's
+// account
+func (k *KeyringSigner) SignBytes(bz []byte) ([]byte, error) {
+	// we are ignoring the returned public key
+	sig, _, err := k.Sign(k.keyringAccName, bz)
+	return sig, err
+}
+
+// SetAccountNumber manually sets the underlying account number
+func (k *KeyringSigner) SetAccountNumber(n uint64) {
+	k.Lock()
+	defer k.Unlock()
 
-	// lookup account info
-	keyInfo, err := k.Key(k.keyringAccName)
+	k.accountNumber = n
+}
+
+// SetSequence manually sets the underlying sequence number
+func (k *KeyringSigner) SetSequence(n uint64) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.sequence = n
+}
+
+// SetSignMode sets the sign mode used to sign transactions and share
+// commitments. Only SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON are
+// supported.
+func (k *KeyringSigner) SetSignMode(mode signing.SignMode) {
+	k.Lock()
+	defer k.Unlock()
+
+	k.signMode = mode
+}
+
+// GetSequence returns the sequence that is used to sign the next transaction
+func (k *KeyringSigner) GetSequence() uint64 {
+	k.RLock()
+	defer k.RUnlock()
+
+	return k.sequence
+}
+
+// BroadcastTx broadcasts a transaction that was built using k.BuildSignedTx.
+// Once the transaction is accepted by the node, the internal sequence is
+// incremented past the sequence of the transaction. If the transaction is
+// rejected because of its sequence, the internal sequence is set to the one
+// expected by the node.
+func (k *KeyringSigner) BroadcastTx(ctx context.Context, conn *grpc.ClientConn, mode tx.BroadcastMode, signedTx authsigning.Tx) (*tx.BroadcastTxResponse, error) {
+	sigs, err := signedTx.GetSignaturesV2()
 	if err != nil {
 		return nil, err
 	}
-
-	// we must first set an empty signature in order generate
-	// the correct sign bytes
-	sigV2 := signing.SignatureV2{
-		PubKey: keyInfo.GetPubKey(),
-		Data: &signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: nil,
-		},
-		Sequence: sequence,
+	if len(sigs) != 1 {
+		return nil, fmt.Errorf("unexpected number of signatures: %d", len(sigs))
 	}
 
-	// set the empty signature
-	err = builder.SetSignatures(sigV2)
+	txBytes, err := k.EncodeTx(signedTx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate the bytes to be signed.
-	bytesToSign, err := k.encCfg.TxConfig.SignModeHandler().GetSignBytes(
-		signing.SignMode_SIGN_MODE_DIRECT,
-		authsigning.SignerData{
-			ChainID:       k.chainID,
-			AccountNumber: accountNumber,
-			Sequence:      sequence,
-		},
-		builder.GetTx(),
-	)
+	resp, err := BroadcastTx(ctx, conn, mode, txBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	// Sign those bytes using the keyring. we are ignoring the returned public key
-	sigBytes, _, err := k.SignByAddress(keyInfo.GetAddress(), bytesToSign)
+	k.updateSequence(sigs[0].Sequence, resp.TxResponse)
+	return resp, nil
+}
+
+// SignAndBroadcastTx signs the share commitments of any wire messages, builds
+// and signs a transaction containing the msgs, and broadcasts it. Each call
+// reserves the sequence following the one reserved by the previous call, so
+// several transactions can be signed and broadcasted concurrently. Using
+// BROADCAST_MODE_SYNC allows them to be submitted in the same block.
+//
+// If the transaction is rejected because the transactions with the previous
+// sequences haven't reached the node yet, it is broadcasted again after a
+// short delay. If its sequence is stale, or the previous sequences never reach
+// the node, the sequence is resynced to the one expected by the node and the
+// transaction is signed again. The sequence of a transaction that is not
+// executed is released, so that it is reused if no later sequence was
+// reserved.
+func (k *KeyringSigner) SignAndBroadcastTx(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	mode tx.BroadcastMode,
+	msgs []sdktypes.Msg,
+	options ...TxBuilderOption,
+) (*tx.BroadcastTxResponse, error) {
+	txBytes, sequence, err := k.signWithNextSequence(msgs, options...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Construct the SignatureV2 struct, this time including a real signature
-	sigV2 = signing.SignatureV2{
-		PubKey: keyInfo.GetPubKey(),
-		Data: &signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: sigBytes,
-		},
-		Sequence: sequence,
+	for attempt := 1; ; attempt++ {
+		resp, err := BroadcastTx(ctx, conn, mode, txBytes)
+		if err != nil {
+			// the tx might not have reached the node
+			k.releaseSequence(sequence)
+			return nil, err
+		}
+
+		txResp := resp.TxResponse
+		if !isWrongSequence(txResp) || attempt == sequenceAttempts {
+			switch {
+			case txResp.Code == abci.CodeTypeOK:
+				k.updateSequence(sequence, txResp)
+			case txResp.Height == 0:
+				// the tx was rejected before it was executed
+				k.releaseSequence(sequence)
+			}
+			return resp, nil
+		}
+
+		expected, ok := parseExpectedSequence(txResp.RawLog)
+		if ok && expected < sequence && attempt < sequenceAttempts-1 {
+			// wait for the txs with the previous sequences to reach the node
+			select {
+			case <-ctx.Done():
+				k.releaseSequence(sequence)
+				return nil, ctx.Err()
+			case <-time.After(sequenceRetryDelay):
+			}
+			continue
+		}
+
+		if ok {
+			k.resyncSequence(sequence, expected)
+		}
+		txBytes, sequence, err = k.signWithNextSequence(msgs, options...)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+const (
+	// sequenceAttempts is the number of times SignAndBroadcastTx broadcasts a
+	// tx that is rejected because of its sequence
+	sequenceAttempts = 5
+	// sequenceRetryDelay is how long SignAndBroadcastTx waits for the txs with
+	// the previous sequences before broadcasting a tx again
+	sequenceRetryDelay = 200 * time.Millisecond
+)
+
+// signWithNextSequence reserves the next sequence and uses it to sign the share
+// commitments of any wire messages and the encoded transaction containing the
+// msgs. The sequence is released if signing fails.
+func (k *KeyringSigner) signWithNextSequence(msgs []sdktypes.Msg, options ...TxBuilderOption) ([]byte, uint64, error) {
+	k.submitMtx.Lock()
+	defer k.submitMtx.Unlock()
+
+	k.Lock()
+	sequence := k.sequence
+	k.sequence++
+	k.Unlock()
+
+	txBytes, err := k.signWithSequence(sequence, msgs, options...)
+	if err != nil {
+		k.releaseSequence(sequence)
+		return nil, 0, err
+	}
+	return txBytes, sequence, nil
+}
+
+// signWithSequence signs the share commitments of any wire messages and
+// returns the encoded transaction containing the msgs, both signed using the
+// provided sequence
+func (k *KeyringSigner) signWithSequence(sequence uint64, msgs []sdktypes.Msg, options ...TxBuilderOption) ([]byte, error) {
+	signer := sequenceSigner{KeyringSigner: k, reserved: sequence}
+	for _, msg := range msgs {
+		wireMsg, ok := msg.(shareCommitmentSigner)
+		if !ok {
+			continue
+		}
+		if err := wireMsg.SignShareCommitments(signer, options...); err != nil {
+			return nil, err
+		}
 	}
 
-	// set the final signature
-	err = builder.SetSignatures(sigV2)
+	builder := k.NewTxBuilder()
+	for _, option := range options {
+		builder = option(builder)
+	}
+	signedTx, err := signer.BuildSignedTx(builder, msgs...)
 	if err != nil {
 		return nil, err
 	}
+	return k.EncodeTx(signedTx)
+}
+
+// releaseSequence makes the reserved sequence available again, unless a later
+// sequence was reserved in the meantime
+func (k *KeyringSigner) releaseSequence(sequence uint64) {
+	k.Lock()
+	defer k.Unlock()
 
-	// return the signed transaction
-	return builder.GetTx(), nil
+	if k.sequence == sequence+1 {
+		k.sequence = sequence
+	}
 }
 
-// SetAccountNumber manually sets the underlying account number
-func (k *KeyringSigner) SetAccountNumber(n uint64) {
+// resyncSequence sets the next sequence to the one expected by the node after
+// a tx signed with the provided sequence was rejected. A stale sequence only
+// moves forward, so that the sequences reserved by other txs are not reused.
+func (k *KeyringSigner) resyncSequence(sequence, expected uint64) {
 	k.Lock()
 	defer k.Unlock()
 
-	k.accountNumber = n
+	if expected < sequence || expected > k.sequence {
+		k.sequence = expected
+	}
 }
 
-// SetSequence manually sets the underlying sequence number
-func (k *KeyringSigner) SetSequence(n uint64) {
+// sequenceSigner signs txs with a sequence reserved by SignAndBroadcastTx
+// instead of the current sequence of the KeyringSigner
+type sequenceSigner struct {
+	*KeyringSigner
+	reserved uint64
+}
+
+// BuildSignedTx fulfills the Signer interface
+func (s sequenceSigner) BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdktypes.Msg) (authsigning.Tx, error) {
+	return s.buildSignedTx(builder, s.reserved, msgs...)
+}
+
+// shareCommitmentSigner is implemented by the wire messages, which include a
+// signature for each of the share commitments
+type shareCommitmentSigner interface {
+	SignShareCommitments(signer Signer, options ...TxBuilderOption) error
+}
+
+// updateSequence updates the internal sequence based on the response of the
+// node to a transaction signed with the provided sequence
+func (k *KeyringSigner) updateSequence(sequence uint64, resp *sdktypes.TxResponse) {
 	k.Lock()
 	defer k.Unlock()
 
-	k.sequence = n
+	if resp.Code == abci.CodeTypeOK {
+		if sequence >= k.sequence {
+			k.sequence = sequence + 1
+		}
+		return
+	}
+
+	if !isWrongSequence(resp) {
+		return
+	}
+	if expected, ok := parseExpectedSequence(resp.RawLog); ok {
+		k.sequence = expected
+	}
+}
+
+// isWrongSequence returns true if the transaction was rejected because it was
+// signed with the wrong sequence
+func isWrongSequence(resp *sdktypes.TxResponse) bool {
+	return resp.Codespace == sdkerrors.RootCodespace && resp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// expectedSequenceRegex matches the sequence expected by the node in the log
+// of a transaction that was rejected because of its sequence
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// parseExpectedSequence returns the sequence expected by the node from the log
+// of a transaction that was rejected because of its sequence
+func parseExpectedSequence(log string) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(log)
+	if len(matches) != 2 {
+		return 0, false
+	}
+	expected, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return expected, true
 }
 
 // SetKeyringAccName manually sets the underlying keyring account name
@@ -178,6 +414,12 @@ func (k *KeyringSigner) EncodeTx(tx sdktypes.Tx) ([]byte, error) {
 	return k.encCfg.TxConfig.TxEncoder()(tx)
 }
 
+// DecodeTx uses the keyring signer's encoding config to decode the provided
+// transaction bytes
+func (k *KeyringSigner) DecodeTx(txBytes []byte) (sdktypes.Tx, error) {
+	return k.encCfg.TxConfig.TxDecoder()(txBytes)
+}
+
 // BroadcastTx uses the provided grpc connection to broadcast a signed and encoded transaction
 func BroadcastTx(ctx context.Context, conn *grpc.ClientConn, mode tx.BroadcastMode, txBytes []byte) (*tx.BroadcastTxResponse, error) {
 	txClient := tx.NewServiceClient(conn)
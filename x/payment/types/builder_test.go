@@ -109,6 +109,29 @@ func TestQueryAccountNumber(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseExpectedSequence(t *testing.T) {
+	type test struct {
+		log      string
+		expected uint64
+		ok       bool
+	}
+	tests := []test{
+		{
+			log:      "account sequence mismatch, expected 12, got 3: incorrect account sequence",
+			expected: 12,
+			ok:       true,
+		},
+		{
+			log: "insufficient fees; got: 1uceles required: 2uceles: insufficient fee",
+		},
+	}
+	for _, tt := range tests {
+		expected, ok := parseExpectedSequence(tt.log)
+		require.Equal(t, tt.ok, ok, tt.log)
+		require.Equal(t, tt.expected, expected, tt.log)
+	}
+}
+
 func generateKeyring(t *testing.T, accts ...string) keyring.Keyring {
 	t.Helper()
 	kb := keyring.NewInMemory()
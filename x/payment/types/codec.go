@@ -12,20 +12,37 @@ import (
 	"github.com/tendermint/spm/cosmoscmd"
 )
 
-func RegisterCodec(cdc *codec.LegacyAmino) {
+// RegisterLegacyAminoCodec registers the payment msgs on the provided amino
+// codec. It is used for the codec that creates the sign bytes of the msgs, and
+// for the codec of the app, which encodes and decodes amino JSON txs.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgPayForMessage{}, "payment/PayForMessage", nil)
 	cdc.RegisterConcrete(&MsgWirePayForMessage{}, "payment/WirePayForMessage", nil)
+	cdc.RegisterConcrete(&MsgWirePayForMessages{}, "payment/WirePayForMessages", nil)
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, "payment/RegisterNamespace", nil)
+	cdc.RegisterConcrete(&MsgTransferNamespace{}, "payment/TransferNamespace", nil)
+	cdc.RegisterConcrete(&MsgUpdateNamespaceWriters{}, "payment/UpdateNamespaceWriters", nil)
+	cdc.RegisterConcrete(&MsgUnregisterNamespace{}, "payment/UnregisterNamespace", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWirePayForMessage{},
+		&MsgWirePayForMessages{},
 	)
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForMessage{},
 	)
 
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterNamespace{},
+		&MsgTransferNamespace{},
+		&MsgUpdateNamespaceWriters{},
+		&MsgUnregisterNamespace{},
+	)
+
 	registry.RegisterInterface(
 		"cosmos.auth.v1beta1.BaseAccount",
 		(*authtypes.AccountI)(nil),
@@ -40,9 +57,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 }
 
 var (
+	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	// AminoCdc is used to create the sign bytes of the payment msgs, which are
+	// signed over when using SIGN_MODE_LEGACY_AMINO_JSON
+	AminoCdc = codec.NewAminoCodec(amino)
 )
 
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
 // makeEncodingConfig is copied here so that we don't have to have an
 //  import cycle. if possible, use cosmoscmd.MakeEncodingConfig
 func makeEncodingConfig() cosmoscmd.EncodingConfig {
@@ -8,5 +8,18 @@ import (
 
 // x/payment module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample                     = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrNamespaceAlreadyRegistered = sdkerrors.Register(ModuleName, 1101, "namespace is already registered")
+	ErrNamespaceNotFound          = sdkerrors.Register(ModuleName, 1102, "namespace is not registered")
+	ErrNotNamespaceOwner          = sdkerrors.Register(ModuleName, 1103, "signer does not own the namespace")
+	ErrNamespaceUnauthorized      = sdkerrors.Register(ModuleName, 1104, "signer cannot pay for messages in the namespace")
+	ErrOrphanMessage              = sdkerrors.Register(ModuleName, 1105, "message is not paid for by any tx in the block")
+	ErrMissingMessage             = sdkerrors.Register(ModuleName, 1106, "no message in the block matches the share commitment")
+	ErrMalformedMalleatedTx       = sdkerrors.Register(ModuleName, 1107, "malformed malleated tx")
+	ErrUnsortedMessages           = sdkerrors.Register(ModuleName, 1108, "messages are not sorted by namespace")
+	ErrPayForMessageNotMalleated  = sdkerrors.Register(ModuleName, 1109, "MsgPayForMessage must be included in a malleated tx")
+	ErrUnmalleatedWireMsg         = sdkerrors.Register(ModuleName, 1110, "wire message must be malleated into a MsgPayForMessage before it is delivered")
+	ErrUnsupportedSignMode        = sdkerrors.Register(ModuleName, 1111, "sign mode is not supported for share commitment signatures")
+	ErrUnalignedMessage           = sdkerrors.Register(ModuleName, 1112, "message does not start at the index of its first subtree")
+	ErrExcessPadding              = sdkerrors.Register(ModuleName, 1113, "padding is not needed to align a message or to fill the square")
 )
@@ -1,23 +1,76 @@
 package types
 
 import (
+	"encoding/hex"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	EventTypePayForMessage = "payformessage"
+	EventTypePayForMessage          = "payformessage"
+	EventTypeRegisterNamespace      = "register_namespace"
+	EventTypeTransferNamespace      = "transfer_namespace"
+	EventTypeUpdateNamespaceWriters = "update_namespace_writers"
+	EventTypeUnregisterNamespace    = "unregister_namespace"
 
-	AttributeKeySigner = "signer"
-	AttributeKeySize   = "size"
+	AttributeKeySigner    = "signer"
+	AttributeKeySize      = "size"
+	AttributeKeyFee       = "fee"
+	AttributeKeyNamespace = "namespace"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyNewOwner  = "new_owner"
+	AttributeKeyWriters   = "writers"
+	AttributeKeyDeposit   = "deposit"
 )
 
 //NewPayForMessageEvent construt a new payformessge sdk.Event
-func NewPayForMessageEvent(signer string, size uint64) sdk.Event {
+func NewPayForMessageEvent(signer string, size uint64, fee sdk.Coins) sdk.Event {
 	return sdk.NewEvent(
 		EventTypePayForMessage,
 		sdk.NewAttribute(AttributeKeySigner, signer),
 		sdk.NewAttribute(AttributeKeySize, strconv.FormatUint(size, 10)),
+		sdk.NewAttribute(AttributeKeyFee, fee.String()),
+	)
+}
+
+// NewRegisterNamespaceEvent constructs a new register_namespace sdk.Event
+func NewRegisterNamespaceEvent(owner string, namespace []byte) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRegisterNamespace,
+		sdk.NewAttribute(AttributeKeyOwner, owner),
+		sdk.NewAttribute(AttributeKeyNamespace, hex.EncodeToString(namespace)),
+	)
+}
+
+// NewTransferNamespaceEvent constructs a new transfer_namespace sdk.Event
+func NewTransferNamespaceEvent(owner string, namespace []byte, newOwner string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTransferNamespace,
+		sdk.NewAttribute(AttributeKeyOwner, owner),
+		sdk.NewAttribute(AttributeKeyNamespace, hex.EncodeToString(namespace)),
+		sdk.NewAttribute(AttributeKeyNewOwner, newOwner),
+	)
+}
+
+// NewUpdateNamespaceWritersEvent constructs a new update_namespace_writers
+// sdk.Event
+func NewUpdateNamespaceWritersEvent(owner string, namespace []byte, writers []string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeUpdateNamespaceWriters,
+		sdk.NewAttribute(AttributeKeyOwner, owner),
+		sdk.NewAttribute(AttributeKeyNamespace, hex.EncodeToString(namespace)),
+		sdk.NewAttribute(AttributeKeyWriters, strings.Join(writers, ",")),
+	)
+}
+
+// NewUnregisterNamespaceEvent constructs a new unregister_namespace sdk.Event
+func NewUnregisterNamespaceEvent(owner string, namespace []byte, deposit sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeUnregisterNamespace,
+		sdk.NewAttribute(AttributeKeyOwner, owner),
+		sdk.NewAttribute(AttributeKeyNamespace, hex.EncodeToString(namespace)),
+		sdk.NewAttribute(AttributeKeyDeposit, deposit.String()),
 	)
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MessageCosts returns the number of message bytes paid for by msg, along with
+// the number of share commitments that are computed to verify them. A
+// MsgPayForMessage is verified against a single commitment, while wire
+// messages commit to every signed square size. Msgs that don't pay for
+// messages have no cost.
+func MessageCosts(msg sdk.Msg) (bytes uint64, commitments uint64) {
+	switch msg := msg.(type) {
+	case *MsgPayForMessage:
+		return msg.MessageSize, 1
+	case *MsgWirePayForMessage:
+		return uint64(len(msg.Message)), uint64(len(msg.MessageShareCommitment))
+	case *MsgWirePayForMessages:
+		for _, wireMsg := range msg.Messages {
+			bytes += uint64(len(wireMsg.Message))
+			commitments += uint64(len(wireMsg.ShareCommitments))
+		}
+		return bytes, commitments
+	default:
+		return 0, 0
+	}
+}
+
+// MessageSizes returns the size of every message paid for by msg, which is
+// what the message fee is charged for. Nil is returned for msgs that don't pay
+// for messages.
+func MessageSizes(msg sdk.Msg) []uint64 {
+	switch msg := msg.(type) {
+	case *MsgPayForMessage:
+		return []uint64{msg.MessageSize}
+	case *MsgWirePayForMessage:
+		return []uint64{uint64(len(msg.Message))}
+	case *MsgWirePayForMessages:
+		sizes := make([]uint64, len(msg.Messages))
+		for i, wireMsg := range msg.Messages {
+			sizes[i] = uint64(len(wireMsg.Message))
+		}
+		return sizes
+	default:
+		return nil
+	}
+}
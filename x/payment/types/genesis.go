@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	"github.com/tendermint/tendermint/pkg/consts"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -11,13 +14,39 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
+		Params:       DefaultParams(),
+		PaidMessages: []PaidMessage{},
+		Namespaces:   []Namespace{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated keys in paidMessage
+	paidMessageKeys := make(map[string]struct{})
+	for _, paidMsg := range gs.PaidMessages {
+		if len(paidMsg.NamespaceId) != consts.NamespaceSize {
+			return fmt.Errorf("invalid namespace length for paid message: %d", len(paidMsg.NamespaceId))
+		}
+		key := string(PaidMessageKey(paidMsg.NamespaceId, paidMsg.Height, paidMsg.TxHash, paidMsg.MessageIndex, paidMsg.ShareCommitment))
+		if _, ok := paidMessageKeys[key]; ok {
+			return fmt.Errorf("duplicated paid message for namespace %X at height %d", paidMsg.NamespaceId, paidMsg.Height)
+		}
+		paidMessageKeys[key] = struct{}{}
+	}
+	// Check for duplicated namespaces
+	namespaceKeys := make(map[string]struct{})
+	for _, ns := range gs.Namespaces {
+		if err := ns.Validate(); err != nil {
+			return fmt.Errorf("invalid namespace %X: %w", ns.NamespaceId, err)
+		}
+		if _, ok := namespaceKeys[string(ns.NamespaceId)]; ok {
+			return fmt.Errorf("duplicated namespace %X", ns.NamespaceId)
+		}
+		namespaceKeys[string(ns.NamespaceId)] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return nil
+	return gs.Params.Validate()
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/x/payment/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,12 +20,89 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				PaidMessages: []types.PaidMessage{
+					{NamespaceId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Height: 1},
+					{NamespaceId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Height: 2},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
+				Params: types.DefaultParams(),
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated paidMessage",
+			genState: &types.GenesisState{
+				PaidMessages: []types.PaidMessage{
+					{NamespaceId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Height: 1},
+					{NamespaceId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Height: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "paidMessage with invalid namespace",
+			genState: &types.GenesisState{
+				PaidMessages: []types.PaidMessage{
+					{NamespaceId: []byte{1, 1, 1}, Height: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid square sizes",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultMaxMessageBytes,
+					types.DefaultFeePerShare,
+					types.DefaultBurnRatio,
+					[]uint64{64, 100},
+					types.DefaultMaxReservedNamespace,
+					types.DefaultNamespaceDeposit,
+					types.DefaultGasPerMessageByte,
+					types.DefaultGasPerCommitment,
+					types.DefaultPaidMessageRetention,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid burn ratio",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultMaxMessageBytes,
+					types.DefaultFeePerShare,
+					sdk.NewDec(2),
+					types.DefaultSquareSizes,
+					types.DefaultMaxReservedNamespace,
+					types.DefaultNamespaceDeposit,
+					types.DefaultGasPerMessageByte,
+					types.DefaultGasPerCommitment,
+					types.DefaultPaidMessageRetention,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "reserved namespace lower than the protocol's",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultMaxMessageBytes,
+					types.DefaultFeePerShare,
+					types.DefaultBurnRatio,
+					types.DefaultSquareSizes,
+					[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+					types.DefaultNamespaceDeposit,
+					types.DefaultGasPerMessageByte,
+					types.DefaultGasPerCommitment,
+					types.DefaultPaidMessageRetention,
+				),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "payment"
@@ -20,3 +26,62 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+const (
+	// PaidMessageKeyPrefix is the prefix used to store the records of paid
+	// messages
+	PaidMessageKeyPrefix = "PaidMessage/value/"
+
+	// PaidMessageHeightKeyPrefix is the prefix used to index the records of
+	// paid messages by height, so that they can be pruned
+	PaidMessageHeightKeyPrefix = "PaidMessage/height/"
+
+	// NamespaceKeyPrefix is the prefix used to store registered namespaces,
+	// which are keyed by their namespace ID
+	NamespaceKeyPrefix = "Namespace/value/"
+)
+
+// PaidMessageKey returns the store key of a paid message record, relative to
+// PaidMessageKeyPrefix. Records are ordered by namespace and then by height,
+// so the messages of a namespace can be iterated over in the order they were
+// included. The index tells apart identical messages paid for by the same
+// transaction.
+func PaidMessageKey(namespace []byte, height int64, txHash []byte, index uint32, commitment []byte) []byte {
+	key := PaidMessageTxPrefix(namespace, height, txHash)
+	key = append(key, uint32ToBigEndian(index)...)
+	return append(key, commitment...)
+}
+
+// PaidMessageTxPrefix returns the prefix of the keys of the messages paid for
+// by a transaction in a namespace, relative to PaidMessageKeyPrefix
+func PaidMessageTxPrefix(namespace []byte, height int64, txHash []byte) []byte {
+	key := make([]byte, 0, len(namespace)+8+len(txHash))
+	key = append(key, namespace...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, txHash...)
+}
+
+// PaidMessageHeightKey returns the key that indexes a paid message record by
+// height, relative to PaidMessageHeightKeyPrefix. It holds the same fields as
+// the PaidMessageKey of the record, with the height first.
+func PaidMessageHeightKey(namespace []byte, height int64, txHash []byte, index uint32, commitment []byte) []byte {
+	key := PaidMessageKey(namespace, height, txHash, index, commitment)
+	copy(key, sdk.Uint64ToBigEndian(uint64(height)))
+	copy(key[8:], namespace)
+	return key
+}
+
+// PaidMessageKeyFromHeightKey returns the PaidMessageKey of the record indexed
+// by a PaidMessageHeightKey
+func PaidMessageKeyFromHeightKey(heightKey []byte) []byte {
+	key := append([]byte{}, heightKey...)
+	copy(key, heightKey[8:8+NamespaceIDSize])
+	copy(key[NamespaceIDSize:], heightKey[:8])
+	return key
+}
+
+func uint32ToBigEndian(i uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, i)
+	return bz
+}
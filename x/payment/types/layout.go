@@ -0,0 +1,355 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/pkg/consts"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// SquareLayout describes where the shares of the block data are placed in a
+// square of a given size. Transactions, intermediate state roots, and evidence
+// are written to contiguous shares at the start of the square. Messages follow,
+// sorted by namespace, and each message starts at an index that is aligned to
+// the width of the first subtree in its merkle mountain range, as required by
+// the non-interactive default rules. This guarantees that the subtree roots
+// used by CreateCommitment are also nodes of the row NMTs.
+//
+// celestia-core writes the messages one after the other, so the padding
+// between them has to be part of the block data. BlockMessages returns the
+// messages along with the padding that produces this layout. Evidence is
+// written before the messages, so its shares are part of the reserved shares
+// and have to be known before the messages are laid out.
+type SquareLayout struct {
+	SquareSize uint64
+	// ReservedShares is the number of shares used by transactions,
+	// intermediate state roots, and evidence
+	ReservedShares uint64
+	// Messages contains the placement of each message, in the order that the
+	// messages appear in the square
+	Messages []MessageLayout
+	// Deferred contains the indexes of the provided messages that could not
+	// be placed in the square
+	Deferred []int
+	// PaddingShares is the number of namespace padding shares used to align
+	// the start of messages
+	PaddingShares uint64
+	// UsedShares is the number of shares used by the reserved shares, the
+	// messages, and the padding between them. Every share after UsedShares is
+	// tail padding.
+	UsedShares uint64
+}
+
+// MessageLayout describes the placement of a single message in the square
+type MessageLayout struct {
+	// Index is the index of the message in the messages provided to
+	// NewSquareLayout
+	Index       int
+	NamespaceID []byte
+	// Start is the index of the first share of the message in the square
+	Start uint64
+	// Shares is the number of shares used by the message
+	Shares uint64
+}
+
+// NewSquareLayout lays out the messages in a square of the provided size after
+// the reserved shares. Messages that would not fit in the square once aligned
+// are deferred and do not take any shares.
+func NewSquareLayout(squareSize, reservedShares uint64, msgs []*tmproto.Message) SquareLayout {
+	layout := SquareLayout{
+		SquareSize:     squareSize,
+		ReservedShares: reservedShares,
+		UsedShares:     reservedShares,
+	}
+
+	// messages are laid out in order of their namespace
+	order := make([]int, len(msgs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bytes.Compare(msgs[order[i]].NamespaceId, msgs[order[j]].NamespaceId) < 0
+	})
+
+	totalShares := squareSize * squareSize
+	if reservedShares > totalShares {
+		layout.Deferred = order
+		return layout
+	}
+
+	for _, i := range order {
+		shares := MessageSharesUsed(len(msgs[i].Data))
+		start := MessageStart(layout.UsedShares, shares, squareSize)
+		if start+shares > totalShares {
+			layout.Deferred = append(layout.Deferred, i)
+			continue
+		}
+
+		layout.Messages = append(layout.Messages, MessageLayout{
+			Index:       i,
+			NamespaceID: msgs[i].NamespaceId,
+			Start:       start,
+			Shares:      shares,
+		})
+		layout.PaddingShares += start - layout.UsedShares
+		layout.UsedShares = start + shares
+	}
+
+	return layout
+}
+
+// Fits returns true if none of the messages were deferred
+func (layout SquareLayout) Fits() bool {
+	return len(layout.Deferred) == 0
+}
+
+// BlockMessages returns the placed messages in the order that they are written
+// to the square, preceded by the padding that aligns their start. The provided
+// messages must be the ones that the layout was created for. A padding share
+// is written as an empty message in the namespace of the message that follows
+// it, which celestia-core splits into a single share that only contains the
+// namespace and zeros.
+func (layout SquareLayout) BlockMessages(msgs []*tmproto.Message) []*tmproto.Message {
+	blockMsgs := make([]*tmproto.Message, 0, uint64(len(layout.Messages))+layout.PaddingShares)
+	cursor := layout.ReservedShares
+	for _, msgLayout := range layout.Messages {
+		for ; cursor < msgLayout.Start; cursor++ {
+			blockMsgs = append(blockMsgs, &tmproto.Message{NamespaceId: msgLayout.NamespaceID})
+		}
+		blockMsgs = append(blockMsgs, msgs[msgLayout.Index])
+		cursor += msgLayout.Shares
+	}
+	return blockMsgs
+}
+
+// TailPadding returns the empty messages that have to follow the messages
+// returned by BlockMessages for celestia-core to derive the square size of the
+// layout. celestia-core uses the smallest square that holds the shares of the
+// block data, so a layout that uses fewer shares is padded until the previous
+// square size is too small. The padding is written in the tail padding
+// namespace, which celestia-core splits into the same shares as the tail
+// padding it adds itself.
+func (layout SquareLayout) TailPadding() []*tmproto.Message {
+	if layout.SquareSize <= consts.MinSquareSize {
+		return nil
+	}
+	smaller := layout.SquareSize / 2
+	if layout.UsedShares > smaller*smaller {
+		return nil
+	}
+
+	// the messages are sorted by namespace, so the padding can't use a lower
+	// namespace than the last message
+	namespace := []byte(consts.TailPaddingNamespaceID)
+	if n := len(layout.Messages); n > 0 && bytes.Compare(layout.Messages[n-1].NamespaceID, namespace) > 0 {
+		namespace = layout.Messages[n-1].NamespaceID
+	}
+
+	padding := make([]*tmproto.Message, smaller*smaller+1-layout.UsedShares)
+	for i := range padding {
+		padding[i] = &tmproto.Message{NamespaceId: namespace}
+	}
+	return padding
+}
+
+// SquareLayoutBuilder lays out txs and their messages in a square of a given
+// size one tx at a time. Adding a tx only moves the messages that are placed
+// after its messages, so that packing a square doesn't lay out every message
+// again for each tx.
+type SquareLayoutBuilder struct {
+	layout SquareLayout
+	// evidenceShares is the number of shares reserved for evidence
+	evidenceShares uint64
+	// txBytes is the size of the added txs once they are prefixed with their
+	// length
+	txBytes uint64
+	// msgCount is the number of added messages
+	msgCount int
+	// msgShares is the number of shares used by the added messages
+	msgShares uint64
+}
+
+// NewSquareLayoutBuilder returns a SquareLayoutBuilder for a square of the
+// provided size that only contains the provided number of evidence shares
+func NewSquareLayoutBuilder(squareSize, evidenceShares uint64) *SquareLayoutBuilder {
+	return &SquareLayoutBuilder{
+		layout: SquareLayout{
+			SquareSize:     squareSize,
+			ReservedShares: evidenceShares,
+			UsedShares:     evidenceShares,
+		},
+		evidenceShares: evidenceShares,
+	}
+}
+
+// TryAdd adds the tx and its messages to the layout if they fit in the square
+// along with everything that was already added, and returns whether they were
+// added. The layout is left unchanged if they don't fit.
+func (b *SquareLayoutBuilder) TryAdd(tx []byte, msgs []*tmproto.Message) bool {
+	txBytes := b.txBytes + delimitedLen(len(tx))
+	reservedShares := divCeil(txBytes, consts.TxShareSize) + b.evidenceShares
+
+	added := make([]MessageLayout, len(msgs))
+	msgShares := b.msgShares
+	for i, msg := range msgs {
+		added[i] = MessageLayout{
+			Index:       b.msgCount + i,
+			NamespaceID: msg.NamespaceId,
+			Shares:      MessageSharesUsed(len(msg.Data)),
+		}
+		msgShares += added[i].Shares
+	}
+	sort.SliceStable(added, func(i, j int) bool {
+		return bytes.Compare(added[i].NamespaceID, added[j].NamespaceID) < 0
+	})
+
+	// merge the messages after the laid out messages with a lower or equal
+	// namespace, keeping track of the first and last merged message
+	laidOut := b.layout.Messages
+	var merged []MessageLayout
+	if len(laidOut)+len(added) > 0 {
+		merged = make([]MessageLayout, 0, len(laidOut)+len(added))
+	}
+	first, last := len(laidOut)+len(added), -1
+	pos := 0
+	for _, msgLayout := range added {
+		for pos < len(laidOut) && bytes.Compare(laidOut[pos].NamespaceID, msgLayout.NamespaceID) <= 0 {
+			merged = append(merged, laidOut[pos])
+			pos++
+		}
+		if len(merged) < first {
+			first = len(merged)
+		}
+		last = len(merged)
+		merged = append(merged, msgLayout)
+	}
+	merged = append(merged, laidOut[pos:]...)
+
+	// every message moves if the txs use more shares, otherwise only the
+	// messages starting at the first merged message do
+	if reservedShares != b.layout.ReservedShares {
+		first = 0
+	}
+	usedShares := reservedShares
+	if first > 0 {
+		usedShares = merged[first-1].Start + merged[first-1].Shares
+	}
+	for i := first; i < len(merged); i++ {
+		start := MessageStart(usedShares, merged[i].Shares, b.layout.SquareSize)
+		if i > last && start == merged[i].Start {
+			// the padding absorbed the shift, so the remaining messages
+			// don't move
+			usedShares = b.layout.UsedShares
+			break
+		}
+		merged[i].Start = start
+		usedShares = start + merged[i].Shares
+	}
+
+	if usedShares > b.layout.SquareSize*b.layout.SquareSize {
+		return false
+	}
+
+	b.layout.ReservedShares = reservedShares
+	b.layout.Messages = merged
+	b.layout.UsedShares = usedShares
+	b.layout.PaddingShares = usedShares - reservedShares - msgShares
+	b.txBytes = txBytes
+	b.msgCount += len(msgs)
+	b.msgShares = msgShares
+	return true
+}
+
+// Layout returns the layout of the added txs and messages. The index of each
+// message is its position among the messages in the order that they were
+// added.
+func (b *SquareLayoutBuilder) Layout() SquareLayout {
+	return b.layout
+}
+
+// TxSharesUsed returns the number of shares used by the provided txs when they
+// are written to contiguous shares
+func TxSharesUsed(txs [][]byte) uint64 {
+	size := uint64(0)
+	for _, tx := range txs {
+		size += delimitedLen(len(tx))
+	}
+	return divCeil(size, consts.TxShareSize)
+}
+
+// EvidenceSharesUsed returns the number of shares used by the provided
+// evidence, which is written to contiguous shares after the txs in the same
+// way as the txs
+func EvidenceSharesUsed(evidence []tmproto.Evidence) uint64 {
+	size := uint64(0)
+	for _, ev := range evidence {
+		size += delimitedLen(ev.Size())
+	}
+	return divCeil(size, consts.TxShareSize)
+}
+
+// MessageSharesUsed returns the number of shares used by a message of the
+// provided size. Each message is prefixed with its length and starts in a new
+// share, so even an empty message uses a single share.
+func MessageSharesUsed(size int) uint64 {
+	return divCeil(delimitedLen(size), consts.MsgShareSize)
+}
+
+// MessageShares splits the message into shares in the same way that
+// celestia-core does. The message is prefixed with its length and broken into
+// MsgShareSize chunks, and each chunk is prefixed with the namespace and zero
+// padded to ShareSize.
+func MessageShares(namespace, message []byte) [][]byte {
+	data := make([]byte, 0, delimitedLen(len(message)))
+	data = append(data, proto.EncodeVarint(uint64(len(message)))...)
+	data = append(data, message...)
+
+	shares := make([][]byte, 0, MessageSharesUsed(len(message)))
+	for len(data) > 0 {
+		chunkSize := consts.MsgShareSize
+		if len(data) < chunkSize {
+			chunkSize = len(data)
+		}
+		share := make([]byte, consts.ShareSize)
+		copy(share, namespace)
+		copy(share[consts.NamespaceSize:], data[:chunkSize])
+		shares = append(shares, share)
+		data = data[chunkSize:]
+	}
+	return shares
+}
+
+// MessageStart returns the index of the first share of a message that uses the
+// provided number of shares, when it is written after cursor shares. The start
+// is aligned to the width of the first subtree of the message.
+func MessageStart(cursor, shares, squareSize uint64) uint64 {
+	return alignUp(cursor, subtreeWidth(shares, squareSize))
+}
+
+// subtreeWidth returns the width of the first subtree in the merkle mountain
+// range of a message that uses the provided number of shares
+func subtreeWidth(shares, squareSize uint64) uint64 {
+	heights := powerOf2MountainRange(shares, squareSize)
+	if len(heights) == 0 {
+		return 1
+	}
+	return heights[0]
+}
+
+// alignUp returns the first index at or after cursor that is a multiple of
+// width
+func alignUp(cursor, width uint64) uint64 {
+	return divCeil(cursor, width) * width
+}
+
+// delimitedLen returns the length of data of the provided size once it is
+// prefixed with its length
+func delimitedLen(size int) uint64 {
+	return uint64(proto.SizeVarint(uint64(size)) + size)
+}
+
+func divCeil(a, b uint64) uint64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,291 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/pkg/consts"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestTxSharesUsed(t *testing.T) {
+	type test struct {
+		name     string
+		txSizes  []int
+		expected uint64
+	}
+	tests := []test{
+		{"no txs", nil, 0},
+		{"single full share", []int{consts.TxShareSize - 2}, 1},
+		{"length prefix spills over", []int{consts.TxShareSize - 1}, 2},
+		{"txs are contiguous", []int{100, 100}, 1},
+		{"multiple shares", []int{consts.TxShareSize * 2}, 3},
+	}
+
+	for _, tt := range tests {
+		txs := make([][]byte, len(tt.txSizes))
+		for i, size := range tt.txSizes {
+			txs[i] = bytes.Repeat([]byte{1}, size)
+		}
+		assert.Equal(t, tt.expected, TxSharesUsed(txs), tt.name)
+	}
+}
+
+func TestMessageSharesUsed(t *testing.T) {
+	assert.Equal(t, uint64(1), MessageSharesUsed(0))
+	assert.Equal(t, uint64(1), MessageSharesUsed(consts.MsgShareSize-2))
+	assert.Equal(t, uint64(2), MessageSharesUsed(consts.MsgShareSize-1))
+	assert.Equal(t, uint64(4), MessageSharesUsed(int(maxMessageSize(4))))
+}
+
+func TestNewSquareLayout(t *testing.T) {
+	type test struct {
+		name             string
+		squareSize       uint64
+		reservedShares   uint64
+		msgShares        []uint64
+		expectedMessages []MessageLayout
+		expectedDeferred []int
+		expectedPadding  uint64
+		expectedUsed     uint64
+	}
+
+	tests := []test{
+		{
+			name:           "no messages",
+			squareSize:     4,
+			reservedShares: 3,
+			expectedUsed:   3,
+		},
+		{
+			name:           "messages are sorted by namespace and aligned",
+			squareSize:     4,
+			reservedShares: 1,
+			msgShares:      []uint64{4, 1},
+			expectedMessages: []MessageLayout{
+				{Index: 1, NamespaceID: testNamespace(1), Start: 1, Shares: 1},
+				{Index: 0, NamespaceID: testNamespace(0), Start: 4, Shares: 4},
+			},
+			expectedPadding: 2,
+			expectedUsed:    8,
+		},
+		{
+			name:           "messages wider than the square start at a row",
+			squareSize:     4,
+			reservedShares: 1,
+			msgShares:      []uint64{6},
+			expectedMessages: []MessageLayout{
+				{Index: 0, NamespaceID: testNamespace(0), Start: 4, Shares: 6},
+			},
+			expectedPadding: 3,
+			expectedUsed:    10,
+		},
+		{
+			name:           "subtree width depends on the message size",
+			squareSize:     4,
+			reservedShares: 1,
+			msgShares:      []uint64{1, 3},
+			expectedMessages: []MessageLayout{
+				{Index: 1, NamespaceID: testNamespace(1), Start: 2, Shares: 3},
+				{Index: 0, NamespaceID: testNamespace(0), Start: 5, Shares: 1},
+			},
+			expectedPadding: 1,
+			expectedUsed:    6,
+		},
+		{
+			name:             "aligned message doesn't fit",
+			squareSize:       2,
+			reservedShares:   1,
+			msgShares:        []uint64{3, 1},
+			expectedDeferred: []int{0},
+			expectedMessages: []MessageLayout{
+				{Index: 1, NamespaceID: testNamespace(1), Start: 1, Shares: 1},
+			},
+			expectedUsed: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		// the namespaces are in reverse order of the messages
+		msgs := make([]*tmproto.Message, len(tt.msgShares))
+		for i, shares := range tt.msgShares {
+			msgs[i] = &tmproto.Message{
+				NamespaceId: testNamespace(i),
+				Data:        bytes.Repeat([]byte{1}, int(maxMessageSize(shares))),
+			}
+		}
+
+		layout := NewSquareLayout(tt.squareSize, tt.reservedShares, msgs)
+		assert.Equal(t, tt.squareSize, layout.SquareSize, tt.name)
+		assert.Equal(t, tt.reservedShares, layout.ReservedShares, tt.name)
+		assert.Equal(t, tt.expectedMessages, layout.Messages, tt.name)
+		assert.Equal(t, tt.expectedDeferred, layout.Deferred, tt.name)
+		assert.Equal(t, len(tt.expectedDeferred) == 0, layout.Fits(), tt.name)
+		assert.Equal(t, tt.expectedPadding, layout.PaddingShares, tt.name)
+		assert.Equal(t, tt.expectedUsed, layout.UsedShares, tt.name)
+
+		// every message starts at an index aligned to its first subtree
+		for _, msgLayout := range layout.Messages {
+			width := powerOf2MountainRange(msgLayout.Shares, tt.squareSize)[0]
+			require.Zero(t, msgLayout.Start%width, tt.name)
+		}
+
+		// celestia-core writes the shares of each message at its start once
+		// the padding is included in the block
+		shares := coreMessageShares(layout.BlockMessages(msgs))
+		require.Len(t, shares, int(layout.UsedShares-layout.ReservedShares), tt.name)
+		for _, msgLayout := range layout.Messages {
+			start := msgLayout.Start - layout.ReservedShares
+			msg := msgs[msgLayout.Index]
+			assert.Equal(t, MessageShares(msg.NamespaceId, msg.Data), shares[start:start+msgLayout.Shares], tt.name)
+		}
+	}
+}
+
+func TestSquareLayoutBuilder(t *testing.T) {
+	type tx struct {
+		size      int
+		msgShares []uint64
+		// namespaces of the messages, as arguments to testNamespace
+		namespaces []int
+	}
+	txs := []tx{
+		{size: 100},
+		{size: 200, msgShares: []uint64{1}, namespaces: []int{5}},
+		{size: 300, msgShares: []uint64{4, 2}, namespaces: []int{3, 7}},
+		{size: 200, msgShares: []uint64{3}, namespaces: []int{5}},
+		{size: 150, msgShares: []uint64{1, 1}, namespaces: []int{9, 1}},
+		{size: 600, msgShares: []uint64{8}, namespaces: []int{6}},
+		{size: 200, msgShares: []uint64{20}, namespaces: []int{2}},
+		{size: 100, msgShares: []uint64{2}, namespaces: []int{8}},
+		{size: 2000, msgShares: []uint64{1}, namespaces: []int{4}},
+		{size: 100, msgShares: []uint64{5}, namespaces: []int{0}},
+	}
+
+	for _, square := range []struct{ size, evidenceShares uint64 }{{4, 0}, {8, 0}, {8, 3}} {
+		squareSize := square.size
+		builder := NewSquareLayoutBuilder(squareSize, square.evidenceShares)
+		var rawTxs [][]byte
+		var msgs []*tmproto.Message
+		for i, tx := range txs {
+			rawTx := bytes.Repeat([]byte{1}, tx.size)
+			var txMsgs []*tmproto.Message
+			for j, shares := range tx.msgShares {
+				txMsgs = append(txMsgs, &tmproto.Message{
+					NamespaceId: testNamespace(tx.namespaces[j]),
+					Data:        bytes.Repeat([]byte{1}, int(maxMessageSize(shares))),
+				})
+			}
+
+			// the builder lays out the txs in the same way as NewSquareLayout
+			expected := NewSquareLayout(squareSize, TxSharesUsed(append(rawTxs, rawTx))+square.evidenceShares, append(msgs, txMsgs...))
+			added := builder.TryAdd(rawTx, txMsgs)
+			require.Equal(t, expected.Fits(), added, "tx %d square size %d", i, squareSize)
+			if added {
+				rawTxs = append(rawTxs, rawTx)
+				msgs = append(msgs, txMsgs...)
+			}
+			assert.Equal(t, NewSquareLayout(squareSize, TxSharesUsed(rawTxs)+square.evidenceShares, msgs), builder.Layout(), "tx %d square size %d", i, squareSize)
+		}
+	}
+}
+
+func TestSquareLayoutBuilderEvidence(t *testing.T) {
+	ev := coretypes.NewMockDuplicateVoteEvidence(1, time.Now(), "test-chain")
+	pev, err := coretypes.EvidenceToProto(ev)
+	require.NoError(t, err)
+	evidenceShares := EvidenceSharesUsed([]tmproto.Evidence{*pev})
+
+	tx := bytes.Repeat([]byte{1}, 100)
+	msgs := []*tmproto.Message{
+		{NamespaceId: testNamespace(1), Data: bytes.Repeat([]byte{2}, int(maxMessageSize(4)))},
+		{NamespaceId: testNamespace(0), Data: bytes.Repeat([]byte{3}, int(maxMessageSize(1)))},
+	}
+	builder := NewSquareLayoutBuilder(8, evidenceShares)
+	require.True(t, builder.TryAdd(tx, msgs))
+	layout := builder.Layout()
+
+	// the messages are laid out after the shares that core uses for the
+	// evidence, so core writes them where the layout places them
+	data := coretypes.Data{
+		Txs:      coretypes.Txs{tx},
+		Evidence: coretypes.EvidenceData{Evidence: coretypes.EvidenceList{ev}},
+	}
+	for _, msg := range layout.BlockMessages(msgs) {
+		data.Messages.MessagesList = append(data.Messages.MessagesList, coretypes.Message{NamespaceID: msg.NamespaceId, Data: msg.Data})
+	}
+	require.Len(t, data.Evidence.SplitIntoShares(), int(evidenceShares))
+	assert.Equal(t, TxSharesUsed([][]byte{tx})+evidenceShares, layout.ReservedShares)
+
+	shares, _ := data.ComputeShares()
+	rawShares := shares.RawShares()
+	require.Len(t, layout.Messages, len(msgs))
+	for _, msgLayout := range layout.Messages {
+		width := powerOf2MountainRange(msgLayout.Shares, layout.SquareSize)[0]
+		assert.Zero(t, msgLayout.Start%width)
+		msg := msgs[msgLayout.Index]
+		assert.Equal(t, MessageShares(msg.NamespaceId, msg.Data), rawShares[msgLayout.Start:msgLayout.Start+msgLayout.Shares])
+	}
+}
+
+func TestTailPadding(t *testing.T) {
+	msgs := []*tmproto.Message{{NamespaceId: testNamespace(0), Data: []byte{1}}}
+
+	type test struct {
+		name            string
+		squareSize      uint64
+		reservedShares  uint64
+		expectedPadding int
+	}
+
+	tests := []test{
+		{"pads up to the square size", 8, 1, 15},
+		{"uses more shares than the smaller square", 4, 4, 0},
+		{"pads the smallest square", 2, 0, 1},
+		{"never pads a square of the min size", 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		layout := NewSquareLayout(tt.squareSize, tt.reservedShares, msgs)
+		padding := layout.TailPadding()
+		assert.Len(t, padding, tt.expectedPadding, tt.name)
+
+		// celestia-core splits the padding into its own tail padding shares
+		// and derives the square size of the layout
+		shares := coreMessageShares(padding)
+		assert.Equal(t, coretypes.TailPaddingShares(len(padding)).RawShares(), shares, tt.name)
+		used := layout.UsedShares + uint64(len(shares))
+		assert.Greater(t, used, tt.squareSize*tt.squareSize/4, tt.name)
+		assert.LessOrEqual(t, used, tt.squareSize*tt.squareSize, tt.name)
+	}
+}
+
+func TestMessageShares(t *testing.T) {
+	namespace := testNamespace(0)
+	for _, size := range []int{0, 1, consts.MsgShareSize - 2, consts.MsgShareSize - 1, 1000} {
+		message := bytes.Repeat([]byte{2}, size)
+		shares := MessageShares(namespace, message)
+		assert.Len(t, shares, int(MessageSharesUsed(size)))
+		assert.Equal(t, coreMessageShares([]*tmproto.Message{{NamespaceId: namespace, Data: message}}), shares)
+	}
+}
+
+// coreMessageShares returns the shares that celestia-core splits the messages
+// into
+func coreMessageShares(msgs []*tmproto.Message) [][]byte {
+	coreMsgs := coretypes.Messages{}
+	for _, msg := range msgs {
+		coreMsgs.MessagesList = append(coreMsgs.MessagesList, coretypes.Message{NamespaceID: msg.NamespaceId, Data: msg.Data})
+	}
+	return coreMsgs.SplitIntoShares().RawShares()
+}
+
+// testNamespace returns a namespace that sorts before the namespaces of lower
+// indexes
+func testNamespace(i int) []byte {
+	return []byte{1, 1, 1, 1, 1, 1, 1, byte(100 - i)}
+}
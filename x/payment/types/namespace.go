@@ -0,0 +1,234 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/pkg/consts"
+)
+
+const (
+	URLMsgRegisterNamespace      = "/payment.MsgRegisterNamespace"
+	URLMsgTransferNamespace      = "/payment.MsgTransferNamespace"
+	URLMsgUpdateNamespaceWriters = "/payment.MsgUpdateNamespaceWriters"
+	URLMsgUnregisterNamespace    = "/payment.MsgUnregisterNamespace"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterNamespace{}
+	_ sdk.Msg = &MsgTransferNamespace{}
+	_ sdk.Msg = &MsgUpdateNamespaceWriters{}
+	_ sdk.Msg = &MsgUnregisterNamespace{}
+)
+
+// CanWrite returns true if the account is the owner or one of the writers of
+// the namespace
+func (ns Namespace) CanWrite(addr string) bool {
+	if ns.Owner == addr {
+		return true
+	}
+	for _, writer := range ns.Writers {
+		if writer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs stateless validity checks on the namespace
+func (ns Namespace) Validate() error {
+	if err := validateNamespaceID(ns.NamespaceId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(ns.Owner); err != nil {
+		return err
+	}
+	if err := validateWriters(ns.Writers); err != nil {
+		return err
+	}
+	return ns.Deposit.Validate()
+}
+
+// NewMsgRegisterNamespace creates a new MsgRegisterNamespace
+func NewMsgRegisterNamespace(owner string, namespace []byte) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{Owner: owner, NamespaceId: namespace}
+}
+
+// Route fullfills the sdk.Msg interface
+func (msg *MsgRegisterNamespace) Route() string { return RouterKey }
+
+// Type fullfills the sdk.Msg interface
+func (msg *MsgRegisterNamespace) Type() string {
+	return URLMsgRegisterNamespace
+}
+
+// ValidateBasic fullfills the sdk.Msg interface by performing stateless
+// validity checks on the msg
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if err := validateNamespaceID(msg.NamespaceId); err != nil {
+		return err
+	}
+	if bytes.Compare(msg.NamespaceId, consts.MaxReservedNamespace) < 1 {
+		return errors.New("cannot register a reserved namespace")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	return err
+}
+
+// GetSignBytes fullfills the sdk.Msg interface by reterning a deterministic set
+// of bytes to sign over
+func (msg *MsgRegisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fullfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+// NewMsgTransferNamespace creates a new MsgTransferNamespace
+func NewMsgTransferNamespace(owner string, namespace []byte, newOwner string) *MsgTransferNamespace {
+	return &MsgTransferNamespace{Owner: owner, NamespaceId: namespace, NewOwner: newOwner}
+}
+
+// Route fullfills the sdk.Msg interface
+func (msg *MsgTransferNamespace) Route() string { return RouterKey }
+
+// Type fullfills the sdk.Msg interface
+func (msg *MsgTransferNamespace) Type() string {
+	return URLMsgTransferNamespace
+}
+
+// ValidateBasic fullfills the sdk.Msg interface by performing stateless
+// validity checks on the msg
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if err := validateNamespaceID(msg.NamespaceId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return fmt.Errorf("invalid new owner: %w", err)
+	}
+	if msg.Owner == msg.NewOwner {
+		return errors.New("new owner is already the owner")
+	}
+	return nil
+}
+
+// GetSignBytes fullfills the sdk.Msg interface by reterning a deterministic set
+// of bytes to sign over
+func (msg *MsgTransferNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fullfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgTransferNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+// NewMsgUpdateNamespaceWriters creates a new MsgUpdateNamespaceWriters
+func NewMsgUpdateNamespaceWriters(owner string, namespace []byte, writers []string) *MsgUpdateNamespaceWriters {
+	return &MsgUpdateNamespaceWriters{Owner: owner, NamespaceId: namespace, Writers: writers}
+}
+
+// Route fullfills the sdk.Msg interface
+func (msg *MsgUpdateNamespaceWriters) Route() string { return RouterKey }
+
+// Type fullfills the sdk.Msg interface
+func (msg *MsgUpdateNamespaceWriters) Type() string {
+	return URLMsgUpdateNamespaceWriters
+}
+
+// ValidateBasic fullfills the sdk.Msg interface by performing stateless
+// validity checks on the msg
+func (msg *MsgUpdateNamespaceWriters) ValidateBasic() error {
+	if err := validateNamespaceID(msg.NamespaceId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return validateWriters(msg.Writers)
+}
+
+// GetSignBytes fullfills the sdk.Msg interface by reterning a deterministic set
+// of bytes to sign over
+func (msg *MsgUpdateNamespaceWriters) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fullfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgUpdateNamespaceWriters) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+// NewMsgUnregisterNamespace creates a new MsgUnregisterNamespace
+func NewMsgUnregisterNamespace(owner string, namespace []byte) *MsgUnregisterNamespace {
+	return &MsgUnregisterNamespace{Owner: owner, NamespaceId: namespace}
+}
+
+// Route fullfills the sdk.Msg interface
+func (msg *MsgUnregisterNamespace) Route() string { return RouterKey }
+
+// Type fullfills the sdk.Msg interface
+func (msg *MsgUnregisterNamespace) Type() string {
+	return URLMsgUnregisterNamespace
+}
+
+// ValidateBasic fullfills the sdk.Msg interface by performing stateless
+// validity checks on the msg
+func (msg *MsgUnregisterNamespace) ValidateBasic() error {
+	if err := validateNamespaceID(msg.NamespaceId); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	return err
+}
+
+// GetSignBytes fullfills the sdk.Msg interface by reterning a deterministic set
+// of bytes to sign over
+func (msg *MsgUnregisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners fullfills the sdk.Msg interface by returning the owner's address
+func (msg *MsgUnregisterNamespace) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Owner)}
+}
+
+func validateNamespaceID(namespace []byte) error {
+	if nsLen := len(namespace); nsLen != NamespaceIDSize {
+		return fmt.Errorf(
+			"invalid namespace length: got %d wanted %d",
+			nsLen,
+			NamespaceIDSize,
+		)
+	}
+	return nil
+}
+
+func validateWriters(writers []string) error {
+	seen := make(map[string]bool)
+	for _, writer := range writers {
+		if _, err := sdk.AccAddressFromBech32(writer); err != nil {
+			return fmt.Errorf("invalid writer %s: %w", writer, err)
+		}
+		if seen[writer] {
+			return fmt.Errorf("duplicate writer: %s", writer)
+		}
+		seen[writer] = true
+	}
+	return nil
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: payment/paid_message.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PaidMessage is the record stored for each executed MsgPayForMessage
+type PaidMessage struct {
+	NamespaceId     []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Signer          string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	MessageSize     uint64 `protobuf:"varint,3,opt,name=message_size,json=messageSize,proto3" json:"message_size,omitempty"`
+	ShareCommitment []byte `protobuf:"bytes,4,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// Height is the height of the block that included the MsgPayForMessage
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// TxHash is the hash of the transaction that paid for the message. For
+	// malleated transactions, this is the hash of the original transaction.
+	TxHash []byte `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// MessageIndex tells apart the messages paid for by the same transaction in
+	// the same namespace, in the order they were paid for
+	MessageIndex uint32 `protobuf:"varint,7,opt,name=message_index,json=messageIndex,proto3" json:"message_index,omitempty"`
+}
+
+func (m *PaidMessage) Reset()         { *m = PaidMessage{} }
+func (m *PaidMessage) String() string { return proto.CompactTextString(m) }
+func (*PaidMessage) ProtoMessage()    {}
+func (*PaidMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df66e01d7be87ee0, []int{0}
+}
+func (m *PaidMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaidMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaidMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaidMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaidMessage.Merge(m, src)
+}
+func (m *PaidMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaidMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaidMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaidMessage proto.InternalMessageInfo
+
+func (m *PaidMessage) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *PaidMessage) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *PaidMessage) GetMessageSize() uint64 {
+	if m != nil {
+		return m.MessageSize
+	}
+	return 0
+}
+
+func (m *PaidMessage) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *PaidMessage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PaidMessage) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *PaidMessage) GetMessageIndex() uint32 {
+	if m != nil {
+		return m.MessageIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PaidMessage)(nil), "payment.PaidMessage")
+}
+
+func init() { proto.RegisterFile("payment/paid_message.proto", fileDescriptor_df66e01d7be87ee0) }
+
+var fileDescriptor_df66e01d7be87ee0 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x5a, 0x12, 0xe1, 0xb4, 0x02, 0x79, 0x00, 0x8b, 0xc1, 0x0a, 0xb0, 0x84, 0x81,
+	0x66, 0xe8, 0x1b, 0xc0, 0x42, 0x87, 0x4a, 0x28, 0x6c, 0x2c, 0x91, 0x9b, 0x9c, 0x62, 0x4b, 0x38,
+	0xb1, 0x62, 0x23, 0xa5, 0x7d, 0x0a, 0x1e, 0x8b, 0xb1, 0x23, 0x23, 0x4a, 0x56, 0x1e, 0x02, 0x25,
+	0x24, 0xd9, 0xee, 0xbe, 0xd3, 0xe9, 0xfb, 0xf5, 0xe3, 0x6b, 0xcd, 0xf7, 0x0a, 0x0a, 0x1b, 0x69,
+	0x2e, 0xb3, 0x44, 0x81, 0x31, 0x3c, 0x87, 0x95, 0xae, 0x4a, 0x5b, 0x12, 0x6f, 0xb8, 0xdd, 0xfe,
+	0x22, 0xec, 0xbf, 0x70, 0x99, 0x6d, 0xff, 0xcf, 0xe4, 0x06, 0x2f, 0x0a, 0xae, 0xc0, 0x68, 0x9e,
+	0x42, 0x22, 0x33, 0x8a, 0x02, 0x14, 0x2e, 0x62, 0x7f, 0x62, 0x9b, 0x8c, 0x5c, 0x62, 0xd7, 0xc8,
+	0xbc, 0x80, 0x8a, 0x9e, 0x04, 0x28, 0x3c, 0x8b, 0x87, 0xad, 0x7b, 0x1d, 0x24, 0x89, 0x91, 0x07,
+	0xa0, 0xb3, 0x00, 0x85, 0xf3, 0xd8, 0x1f, 0xd8, 0xab, 0x3c, 0x00, 0xb9, 0xc7, 0x17, 0x46, 0xf0,
+	0x0a, 0x92, 0xb4, 0x54, 0x4a, 0xda, 0x2e, 0x01, 0x9d, 0xf7, 0x86, 0xf3, 0x9e, 0x3f, 0x4d, 0xb8,
+	0xb3, 0x08, 0x90, 0xb9, 0xb0, 0xf4, 0x34, 0x40, 0xe1, 0x2c, 0x1e, 0x36, 0x72, 0x85, 0x3d, 0x5b,
+	0x27, 0x82, 0x1b, 0x41, 0xdd, 0xfe, 0xd3, 0xb5, 0xf5, 0x33, 0x37, 0x82, 0xdc, 0xe1, 0xe5, 0xa8,
+	0x97, 0x45, 0x06, 0x35, 0xf5, 0x02, 0x14, 0x2e, 0xe3, 0x31, 0xd3, 0xa6, 0x63, 0x8f, 0xdb, 0xaf,
+	0x86, 0xa1, 0x63, 0xc3, 0xd0, 0x4f, 0xc3, 0xd0, 0x67, 0xcb, 0x9c, 0x63, 0xcb, 0x9c, 0xef, 0x96,
+	0x39, 0x6f, 0xeb, 0x5c, 0x5a, 0xf1, 0xb1, 0x5b, 0xa5, 0xa5, 0x8a, 0x52, 0x78, 0x07, 0x63, 0x25,
+	0x2f, 0xab, 0x7c, 0x9a, 0x1f, 0xb8, 0xd6, 0x51, 0x1d, 0x8d, 0x9d, 0xda, 0xbd, 0x06, 0xb3, 0x73,
+	0xfb, 0x36, 0xd7, 0x7f, 0x03, 0x00, 0xc1, 0xd4, 0xfc, 0xb1, 0x6b, 0x01, 0x00, 0x00,
+}
+
+func (m *PaidMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaidMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaidMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageIndex != 0 {
+		i = encodeVarintPaidMessage(dAtA, i, uint64(m.MessageIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintPaidMessage(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintPaidMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintPaidMessage(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MessageSize != 0 {
+		i = encodeVarintPaidMessage(dAtA, i, uint64(m.MessageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintPaidMessage(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintPaidMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaidMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaidMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PaidMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovPaidMessage(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovPaidMessage(uint64(l))
+	}
+	if m.MessageSize != 0 {
+		n += 1 + sovPaidMessage(uint64(m.MessageSize))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovPaidMessage(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPaidMessage(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovPaidMessage(uint64(l))
+	}
+	if m.MessageIndex != 0 {
+		n += 1 + sovPaidMessage(uint64(m.MessageIndex))
+	}
+	return n
+}
+
+func sovPaidMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaidMessage(x uint64) (n int) {
+	return sovPaidMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PaidMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaidMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaidMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaidMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageSize", wireType)
+			}
+			m.MessageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIndex", wireType)
+			}
+			m.MessageIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaidMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaidMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaidMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaidMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaidMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaidMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaidMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaidMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaidMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaidMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaidMessage = fmt.Errorf("proto: unexpected end of group")
+)
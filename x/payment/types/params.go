@@ -0,0 +1,289 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/pkg/consts"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyMaxMessageBytes      = []byte("MaxMessageBytes")
+	KeyFeePerShare          = []byte("FeePerShare")
+	KeyBurnRatio            = []byte("BurnRatio")
+	KeySquareSizes          = []byte("SquareSizes")
+	KeyMaxReservedNamespace = []byte("MaxReservedNamespace")
+	KeyNamespaceDeposit     = []byte("NamespaceDeposit")
+	KeyGasPerMessageByte    = []byte("GasPerMessageByte")
+	KeyGasPerCommitment     = []byte("GasPerCommitment")
+	KeyPaidMessageRetention = []byte("PaidMessageRetention")
+)
+
+var (
+	// DefaultMaxMessageBytes is the size of the largest message that fits in
+	// the largest square, leaving a single share for the transaction paying
+	// for it
+	DefaultMaxMessageBytes = maxMessageSize(consts.MaxShareCount - 1)
+	// DefaultFeePerShare is the default amount of the bond denom charged
+	// for each share that a message occupies
+	DefaultFeePerShare = sdk.NewInt(1)
+	// DefaultBurnRatio is the default portion of each message fee that is
+	// burned. The remainder is paid to the block proposer.
+	DefaultBurnRatio = sdk.NewDecWithPrec(5, 1)
+	// DefaultSquareSizes are every power of two between the minimum and
+	// maximum square size
+	DefaultSquareSizes = defaultSquareSizes()
+	// DefaultMaxReservedNamespace is the namespace reserved by the protocol
+	DefaultMaxReservedNamespace = []byte(consts.MaxReservedNamespace)
+	// DefaultNamespaceDeposit is the default amount of the bond denom escrowed
+	// when registering a namespace
+	DefaultNamespaceDeposit = sdk.NewInt(1000)
+	// DefaultGasPerMessageByte is the default gas consumed for each byte of a
+	// message that is paid for
+	DefaultGasPerMessageByte = uint64(8)
+	// DefaultGasPerCommitment is the default gas consumed for each share
+	// commitment that is computed to verify a message
+	DefaultGasPerCommitment = uint64(2000)
+	// DefaultPaidMessageRetention is the default number of blocks that the
+	// records of paid messages are kept for
+	DefaultPaidMessageRetention = uint64(100000)
+)
+
+// ParamKeyTable returns the param key table for the payment module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxMessageBytes uint64,
+	feePerShare sdk.Int,
+	burnRatio sdk.Dec,
+	squareSizes []uint64,
+	maxReservedNamespace []byte,
+	namespaceDeposit sdk.Int,
+	gasPerMessageByte uint64,
+	gasPerCommitment uint64,
+	paidMessageRetention uint64,
+) Params {
+	return Params{
+		MaxMessageBytes:      maxMessageBytes,
+		FeePerShare:          feePerShare,
+		BurnRatio:            burnRatio,
+		SquareSizes:          squareSizes,
+		MaxReservedNamespace: maxReservedNamespace,
+		NamespaceDeposit:     namespaceDeposit,
+		GasPerMessageByte:    gasPerMessageByte,
+		GasPerCommitment:     gasPerCommitment,
+		PaidMessageRetention: paidMessageRetention,
+	}
+}
+
+// DefaultParams returns the default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxMessageBytes,
+		DefaultFeePerShare,
+		DefaultBurnRatio,
+		DefaultSquareSizes,
+		DefaultMaxReservedNamespace,
+		DefaultNamespaceDeposit,
+		DefaultGasPerMessageByte,
+		DefaultGasPerCommitment,
+		DefaultPaidMessageRetention,
+	)
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMessageBytes, &p.MaxMessageBytes, validateMaxMessageBytes),
+		paramtypes.NewParamSetPair(KeyFeePerShare, &p.FeePerShare, validateFeePerShare),
+		paramtypes.NewParamSetPair(KeyBurnRatio, &p.BurnRatio, validateBurnRatio),
+		paramtypes.NewParamSetPair(KeySquareSizes, &p.SquareSizes, validateSquareSizes),
+		paramtypes.NewParamSetPair(KeyMaxReservedNamespace, &p.MaxReservedNamespace, validateMaxReservedNamespace),
+		paramtypes.NewParamSetPair(KeyNamespaceDeposit, &p.NamespaceDeposit, validateNamespaceDeposit),
+		paramtypes.NewParamSetPair(KeyGasPerMessageByte, &p.GasPerMessageByte, validateGas),
+		paramtypes.NewParamSetPair(KeyGasPerCommitment, &p.GasPerCommitment, validateGas),
+		paramtypes.NewParamSetPair(KeyPaidMessageRetention, &p.PaidMessageRetention, validatePaidMessageRetention),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxMessageBytes(p.MaxMessageBytes); err != nil {
+		return err
+	}
+	if err := validateFeePerShare(p.FeePerShare); err != nil {
+		return err
+	}
+	if err := validateBurnRatio(p.BurnRatio); err != nil {
+		return err
+	}
+	if err := validateSquareSizes(p.SquareSizes); err != nil {
+		return err
+	}
+	if err := validateMaxReservedNamespace(p.MaxReservedNamespace); err != nil {
+		return err
+	}
+	if err := validateNamespaceDeposit(p.NamespaceDeposit); err != nil {
+		return err
+	}
+	if err := validateGas(p.GasPerMessageByte); err != nil {
+		return err
+	}
+	if err := validateGas(p.GasPerCommitment); err != nil {
+		return err
+	}
+	return validatePaidMessageRetention(p.PaidMessageRetention)
+}
+
+// HasSquareSize returns true if k is one of the square sizes blocks can be
+// built with
+func (p Params) HasSquareSize(k uint64) bool {
+	for _, size := range p.SquareSizes {
+		if size == k {
+			return true
+		}
+	}
+	return false
+}
+
+// FittingSquareSizes returns the square sizes that have enough shares to hold
+// a message of the provided size along with the share used by its transaction
+func (p Params) FittingSquareSizes(msgSize uint64) []uint64 {
+	shares := MessageShareCount(msgSize)
+	var fitting []uint64
+	for _, k := range p.SquareSizes {
+		if shares < k*k {
+			fitting = append(fitting, k)
+		}
+	}
+	return fitting
+}
+
+// maxMessageSize returns the size of the largest message that fits in the
+// provided number of shares once it is prefixed with its length
+func maxMessageSize(shares uint64) uint64 {
+	size := shares * consts.MsgShareSize
+	for MessageSharesUsed(int(size)) > shares {
+		size--
+	}
+	return size
+}
+
+func validateMaxMessageBytes(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max message bytes must be positive: %d", v)
+	}
+	if v > DefaultMaxMessageBytes {
+		return fmt.Errorf("max message bytes cannot exceed %d: %d", DefaultMaxMessageBytes, v)
+	}
+	return nil
+}
+
+func validateFeePerShare(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fee per share cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn ratio must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSquareSizes(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return fmt.Errorf("at least one square size is required")
+	}
+	seen := make(map[uint64]bool)
+	for _, size := range v {
+		if !powerOf2(size) {
+			return fmt.Errorf("invalid square size, the size must be power of 2: %d", size)
+		}
+		if size < consts.MinSquareSize || size > consts.MaxSquareSize {
+			return fmt.Errorf(
+				"square size must be between %d and %d: %d",
+				consts.MinSquareSize,
+				consts.MaxSquareSize,
+				size,
+			)
+		}
+		if seen[size] {
+			return fmt.Errorf("duplicate square size: %d", size)
+		}
+		seen[size] = true
+	}
+	return nil
+}
+
+func validateMaxReservedNamespace(i interface{}) error {
+	v, ok := i.([]byte)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) != NamespaceIDSize {
+		return fmt.Errorf("invalid namespace length: got %d wanted %d", len(v), NamespaceIDSize)
+	}
+	if bytes.Compare(v, consts.MaxReservedNamespace) < 0 {
+		return fmt.Errorf("max reserved namespace cannot be lower than %X", []byte(consts.MaxReservedNamespace))
+	}
+	return nil
+}
+
+func validateNamespaceDeposit(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("namespace deposit cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePaidMessageRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func defaultSquareSizes() []uint64 {
+	var sizes []uint64
+	for size := uint64(consts.MinSquareSize); size <= consts.MaxSquareSize; size *= 2 {
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: payment/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the payment module.
+type Params struct {
+	// MaxMessageBytes is the largest message that can be paid for
+	MaxMessageBytes uint64 `protobuf:"varint,1,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty" yaml:"max_message_bytes"`
+	// FeePerShare is the amount of the bond denom charged for each share
+	// that a message occupies
+	FeePerShare github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee_per_share,json=feePerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_per_share" yaml:"fee_per_share"`
+	// BurnRatio is the portion of each message fee that is burned. The
+	// remainder is paid to the block proposer.
+	BurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio" yaml:"burn_ratio"`
+	// SquareSizes are the square sizes that blocks can be built with. A wire
+	// message must commit to at least one of them to be included in a block.
+	SquareSizes []uint64 `protobuf:"varint,4,rep,packed,name=square_sizes,json=squareSizes,proto3" json:"square_sizes,omitempty" yaml:"square_sizes"`
+	// MaxReservedNamespace is the lexicographically largest namespace that
+	// cannot be used by messages
+	MaxReservedNamespace []byte `protobuf:"bytes,5,opt,name=max_reserved_namespace,json=maxReservedNamespace,proto3" json:"max_reserved_namespace,omitempty" yaml:"max_reserved_namespace"`
+	// NamespaceDeposit is the amount of the bond denom escrowed when
+	// registering a namespace
+	NamespaceDeposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=namespace_deposit,json=namespaceDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"namespace_deposit" yaml:"namespace_deposit"`
+	// GasPerMessageByte is the gas consumed for each byte of a message that is
+	// paid for
+	GasPerMessageByte uint64 `protobuf:"varint,7,opt,name=gas_per_message_byte,json=gasPerMessageByte,proto3" json:"gas_per_message_byte,omitempty" yaml:"gas_per_message_byte"`
+	// GasPerCommitment is the gas consumed for each share commitment that is
+	// computed to verify a message
+	GasPerCommitment uint64 `protobuf:"varint,8,opt,name=gas_per_commitment,json=gasPerCommitment,proto3" json:"gas_per_commitment,omitempty" yaml:"gas_per_commitment"`
+	// PaidMessageRetention is the number of blocks that the records of paid
+	// messages are kept for. Zero keeps them forever.
+	PaidMessageRetention uint64 `protobuf:"varint,9,opt,name=paid_message_retention,json=paidMessageRetention,proto3" json:"paid_message_retention,omitempty" yaml:"paid_message_retention"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d54b052075926a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMessageBytes() uint64 {
+	if m != nil {
+		return m.MaxMessageBytes
+	}
+	return 0
+}
+
+func (m *Params) GetSquareSizes() []uint64 {
+	if m != nil {
+		return m.SquareSizes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxReservedNamespace() []byte {
+	if m != nil {
+		return m.MaxReservedNamespace
+	}
+	return nil
+}
+
+func (m *Params) GetGasPerMessageByte() uint64 {
+	if m != nil {
+		return m.GasPerMessageByte
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerCommitment() uint64 {
+	if m != nil {
+		return m.GasPerCommitment
+	}
+	return 0
+}
+
+func (m *Params) GetPaidMessageRetention() uint64 {
+	if m != nil {
+		return m.PaidMessageRetention
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "payment.Params")
+}
+
+func init() { proto.RegisterFile("payment/params.proto", fileDescriptor_12d54b052075926a) }
+
+var fileDescriptor_12d54b052075926a = []byte{
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x69, 0x48, 0xc9, 0xb4, 0x88, 0xc6, 0x58, 0x60, 0x1e, 0xb5, 0xc3, 0x2c, 0x50, 0x36,
+	0x8d, 0x17, 0xdd, 0x75, 0xe9, 0x56, 0x88, 0x87, 0x8a, 0xa2, 0xe9, 0x02, 0x89, 0x8d, 0x35, 0x49,
+	0x6e, 0x5d, 0x43, 0xc7, 0x33, 0xcc, 0x4c, 0x20, 0xe1, 0x2b, 0xf8, 0x0c, 0x3e, 0xa5, 0xcb, 0x2e,
+	0x11, 0x0b, 0x0b, 0x25, 0x7f, 0xe0, 0x2f, 0x40, 0x33, 0xce, 0xab, 0x4a, 0x37, 0x5d, 0xf9, 0xde,
+	0x73, 0x8f, 0xcf, 0xb1, 0xcf, 0xdc, 0x41, 0x9e, 0xa0, 0x13, 0x06, 0xb9, 0x8e, 0x04, 0x95, 0x94,
+	0xa9, 0xae, 0x90, 0x5c, 0x73, 0x77, 0x7b, 0x8e, 0x3e, 0xf7, 0x52, 0x9e, 0x72, 0x8b, 0x45, 0xa6,
+	0xaa, 0xc6, 0xf8, 0x77, 0x03, 0x35, 0x7a, 0x96, 0xef, 0xbe, 0x45, 0x2d, 0x46, 0xc7, 0x09, 0x03,
+	0xa5, 0x68, 0x0a, 0x49, 0x7f, 0xa2, 0x41, 0xf9, 0x4e, 0xdb, 0xe9, 0xd4, 0xe3, 0x97, 0x65, 0x11,
+	0xfa, 0x13, 0xca, 0x2e, 0x8f, 0xf0, 0x06, 0x05, 0x93, 0x47, 0x8c, 0x8e, 0x4f, 0x2b, 0x28, 0x36,
+	0x88, 0xfb, 0x05, 0x3d, 0x3c, 0x07, 0x48, 0x04, 0xc8, 0x44, 0x5d, 0x50, 0x09, 0xfe, 0xbd, 0xb6,
+	0xd3, 0x69, 0xc6, 0x6f, 0xae, 0x8a, 0xb0, 0xf6, 0xb7, 0x08, 0x5f, 0xa7, 0x99, 0xbe, 0x18, 0xf5,
+	0xbb, 0x03, 0xce, 0xa2, 0x01, 0x57, 0x8c, 0xab, 0xf9, 0xe3, 0x40, 0x0d, 0xbf, 0x46, 0x7a, 0x22,
+	0x40, 0x75, 0xdf, 0xe5, 0xba, 0x2c, 0x42, 0xaf, 0xf2, 0xbc, 0x21, 0x86, 0xc9, 0xce, 0x39, 0x40,
+	0x0f, 0xe4, 0x99, 0xe9, 0xdc, 0x3e, 0x42, 0xfd, 0x91, 0xcc, 0x13, 0x49, 0x75, 0xc6, 0xfd, 0x2d,
+	0x6b, 0x74, 0x7c, 0x07, 0xa3, 0x13, 0x18, 0x94, 0x45, 0xd8, 0xaa, 0x8c, 0x56, 0x4a, 0x98, 0x34,
+	0x4d, 0x43, 0x4c, 0xed, 0x1e, 0xa1, 0x5d, 0xf5, 0x6d, 0x44, 0x25, 0x24, 0x2a, 0xfb, 0x09, 0xca,
+	0xaf, 0xb7, 0xb7, 0x3a, 0xf5, 0xf8, 0x69, 0x59, 0x84, 0x8f, 0xab, 0xf7, 0xd6, 0xa7, 0x98, 0xec,
+	0x54, 0xed, 0x99, 0xe9, 0xdc, 0x4f, 0xe8, 0x89, 0x89, 0x4c, 0x82, 0x02, 0xf9, 0x1d, 0x86, 0x49,
+	0x4e, 0x19, 0x28, 0x41, 0x07, 0xe0, 0xdf, 0x6f, 0x3b, 0x9d, 0xdd, 0xf8, 0x55, 0x59, 0x84, 0xfb,
+	0xab, 0x68, 0x37, 0x79, 0x98, 0x78, 0x8c, 0x8e, 0xc9, 0x1c, 0xff, 0xb8, 0x80, 0xdd, 0x1f, 0xa8,
+	0xb5, 0xe4, 0x24, 0x43, 0x10, 0x5c, 0x65, 0xda, 0x6f, 0xd8, 0xff, 0x7f, 0x7f, 0xe7, 0xa0, 0xe7,
+	0x87, 0xbb, 0x21, 0x88, 0xc9, 0xde, 0x12, 0x3b, 0xa9, 0x20, 0xb7, 0x87, 0xbc, 0x94, 0x2a, 0x7b,
+	0x20, 0xeb, 0x8b, 0xe0, 0x6f, 0xdb, 0x55, 0x09, 0xcb, 0x22, 0x7c, 0x51, 0xa9, 0xdd, 0xc6, 0xc2,
+	0xa4, 0x95, 0x52, 0xd5, 0x03, 0xb9, 0xb6, 0x30, 0xee, 0x07, 0xe4, 0x2e, 0xb8, 0x03, 0xce, 0x58,
+	0xa6, 0xcd, 0xc2, 0xfa, 0x0f, 0xac, 0xde, 0x7e, 0x59, 0x84, 0xcf, 0x6e, 0xea, 0xad, 0x38, 0x98,
+	0xec, 0x55, 0x6a, 0xc7, 0x4b, 0xc8, 0x04, 0x2e, 0x68, 0x36, 0x5c, 0xba, 0x4a, 0xd0, 0x90, 0xeb,
+	0x8c, 0xe7, 0x7e, 0xd3, 0x0a, 0xae, 0x05, 0x7e, 0x3b, 0x0f, 0x13, 0xcf, 0x0c, 0xe6, 0x1f, 0x48,
+	0x16, 0x70, 0x7c, 0x7a, 0x35, 0x0d, 0x9c, 0xeb, 0x69, 0xe0, 0xfc, 0x9b, 0x06, 0xce, 0xaf, 0x59,
+	0x50, 0xbb, 0x9e, 0x05, 0xb5, 0x3f, 0xb3, 0xa0, 0xf6, 0xf9, 0x70, 0x3d, 0x67, 0xb8, 0x04, 0xa5,
+	0x33, 0xca, 0x65, 0xba, 0xac, 0x0f, 0xa8, 0x10, 0xd1, 0x38, 0x5a, 0xdc, 0x4f, 0x1b, 0x7c, 0xbf,
+	0x61, 0x2f, 0xe0, 0xe1, 0xff, 0x01, 0x00, 0xad, 0x30, 0xac, 0xaf, 0xb7, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaidMessageRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PaidMessageRetention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasPerCommitment != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPerCommitment))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPerMessageByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPerMessageByte))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.NamespaceDeposit.Size()
+		i -= size
+		if _, err := m.NamespaceDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.MaxReservedNamespace) > 0 {
+		i -= len(m.MaxReservedNamespace)
+		copy(dAtA[i:], m.MaxReservedNamespace)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxReservedNamespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SquareSizes) > 0 {
+		dAtA2 := make([]byte, len(m.SquareSizes)*10)
+		var j1 int
+		for _, num := range m.SquareSizes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeePerShare.Size()
+		i -= size
+		if _, err := m.FeePerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxMessageBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMessageBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMessageBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxMessageBytes))
+	}
+	l = m.FeePerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.SquareSizes) > 0 {
+		l = 0
+		for _, e := range m.SquareSizes {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	l = len(m.MaxReservedNamespace)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.NamespaceDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.GasPerMessageByte != 0 {
+		n += 1 + sovParams(uint64(m.GasPerMessageByte))
+	}
+	if m.GasPerCommitment != 0 {
+		n += 1 + sovParams(uint64(m.GasPerCommitment))
+	}
+	if m.PaidMessageRetention != 0 {
+		n += 1 + sovParams(uint64(m.PaidMessageRetention))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageBytes", wireType)
+			}
+			m.MaxMessageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SquareSizes = append(m.SquareSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SquareSizes) == 0 {
+					m.SquareSizes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SquareSizes = append(m.SquareSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSizes", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReservedNamespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxReservedNamespace = append(m.MaxReservedNamespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.MaxReservedNamespace == nil {
+				m.MaxReservedNamespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NamespaceDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerMessageByte", wireType)
+			}
+			m.GasPerMessageByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerMessageByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerCommitment", wireType)
+			}
+			m.GasPerCommitment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerCommitment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidMessageRetention", wireType)
+			}
+			m.PaidMessageRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidMessageRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
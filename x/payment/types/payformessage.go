@@ -14,11 +14,12 @@ import (
 )
 
 const (
-	URLMsgWirePayforMessage = "/payment.MsgWirePayForMessage"
-	URLMsgPayforMessage     = "/payment.MsgPayForMessage"
-	ShareSize               = consts.ShareSize
-	SquareSize              = consts.MaxSquareSize
-	NamespaceIDSize         = consts.NamespaceSize
+	URLMsgWirePayforMessage  = "/payment.MsgWirePayForMessage"
+	URLMsgWirePayForMessages = "/payment.MsgWirePayForMessages"
+	URLMsgPayforMessage      = "/payment.MsgPayForMessage"
+	ShareSize                = consts.ShareSize
+	SquareSize               = consts.MaxSquareSize
+	NamespaceIDSize          = consts.NamespaceSize
 )
 
 var _ sdk.Msg = &MsgPayForMessage{}
@@ -54,7 +55,7 @@ func (msg *MsgPayForMessage) ValidateBasic() error {
 // GetSignBytes fullfills the sdk.Msg interface by reterning a deterministic set
 // of bytes to sign over
 func (msg *MsgPayForMessage) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
 
 // GetSigners fullfills the sdk.Msg interface by returning the signer's address
@@ -67,15 +68,21 @@ func (msg *MsgPayForMessage) GetSigners() []sdk.AccAddress {
 }
 
 // BuildPayForMessageTxFromWireTx creates an authsigning.Tx using data from the original
-// MsgWirePayForMessage sdk.Tx and the signature provided. This is used while processing
-// the MsgWirePayForMessages into Signed  MsgPayForMessage
+// MsgWirePayForMessage or MsgWirePayForMessages sdk.Tx and the signature provided. This
+// is used while processing the wire messages into a single signed tx containing a
+// MsgPayForMessage for each message. The signature must have been created using
+// its sign mode.
 func BuildPayForMessageTxFromWireTx(
 	origTx authsigning.Tx,
 	builder sdkclient.TxBuilder,
-	signature []byte,
-	msg *MsgPayForMessage,
+	signature *signing.SingleSignatureData,
+	msgs ...*MsgPayForMessage,
 ) (authsigning.Tx, error) {
-	err := builder.SetMsgs(msg)
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		sdkMsgs[i] = msg
+	}
+	err := builder.SetMsgs(sdkMsgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,8 +100,8 @@ func BuildPayForMessageTxFromWireTx(
 	newSig := signing.SignatureV2{
 		PubKey: origSigs[0].PubKey,
 		Data: &signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: signature,
+			SignMode:  signature.SignMode,
+			Signature: signature.Signature,
 		},
 		Sequence: origSigs[0].Sequence,
 	}
@@ -111,11 +118,34 @@ func BuildPayForMessageTxFromWireTx(
 // squaresize using a namespace merkle tree and the rules described at
 // https://github.com/celestiaorg/celestia-specs/blob/master/src/rationale/message_block_layout.md#message-layout-rationale
 func CreateCommitment(k uint64, namespace, message []byte) ([]byte, error) {
-	// add padding to the message if necessary
-	message = padMessage(message)
+	subtrees, err := CommitmentSubtrees(k, namespace, message)
+	if err != nil {
+		return nil, err
+	}
 
+	subTreeRoots := make([][]byte, len(subtrees))
+	for i, subtree := range subtrees {
+		subTreeRoots[i] = subtree.Root
+	}
+	return merkle.HashFromByteSlices(subTreeRoots), nil
+}
+
+// CommitmentSubtree is one of the subtrees of the merkle mountain range that
+// the share commitment of a message is created over
+type CommitmentSubtree struct {
+	// Width is the number of shares of the message in the subtree
+	Width uint64
+	// Root is the root of the namespace merkle tree of the subtree
+	Root []byte
+}
+
+// CommitmentSubtrees breaks the message into shares and returns the subtrees
+// of the merkle mountain range that the share commitment for the square size
+// is created over. The shares are the same as the ones that celestia-core
+// writes to the square for the message.
+func CommitmentSubtrees(k uint64, namespace, message []byte) ([]CommitmentSubtree, error) {
 	// break message into shares
-	shares := chunkMessage(message)
+	shares := MessageShares(namespace, message)
 	// if the number of shares is larger than that in the square, throw an error
 	// note, we use k*k-1 here because at least a single share will be reserved
 	// for the transaction paying for the message, therefore the max number of
@@ -134,11 +164,13 @@ func CreateCommitment(k uint64, namespace, message []byte) ([]byte, error) {
 	}
 
 	// create the commits by pushing each leaf set onto an nmt
-	subTreeRoots := make([][]byte, len(leafSets))
+	subtrees := make([]CommitmentSubtree, len(leafSets))
 	for i, set := range leafSets {
 		// create the nmt todo(evan) use nmt wrapper
 		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(NamespaceIDSize))
 		for _, leaf := range set {
+			// like the row trees of celestia-core, the namespace is pushed
+			// in front of the share, which already starts with it
 			nsLeaf := append(make([]byte, 0), append(namespace, leaf...)...)
 			err := tree.Push(nsLeaf)
 			if err != nil {
@@ -146,37 +178,29 @@ func CreateCommitment(k uint64, namespace, message []byte) ([]byte, error) {
 			}
 		}
 		// add the root
-		subTreeRoots[i] = tree.Root()
+		subtrees[i] = CommitmentSubtree{Width: heights[i], Root: tree.Root()}
 	}
-	return merkle.HashFromByteSlices(subTreeRoots), nil
+	return subtrees, nil
 }
 
-// chunkMessage breaks the message into ShareSize pieces
-func chunkMessage(message []byte) [][]byte {
-	var shares [][]byte
-	for i := 0; i < len(message); i += ShareSize {
-		end := i + ShareSize
-		if end > len(message) {
-			end = len(message)
-		}
-		shares = append(shares, message[i:end])
-	}
-	return shares
+// MessageShareCount returns the number of shares a message of the provided
+// size occupies
+func MessageShareCount(size uint64) uint64 {
+	return MessageSharesUsed(int(size))
 }
 
-// padMessage adds padding to the msg if the length of the msg is not divisible
-// by the share size specified in celestia-core
-func padMessage(msg []byte) []byte {
-	// check if the message needs padding
-	if uint64(len(msg))%ShareSize == 0 {
-		return msg
+// TrimMessagePadding removes the zero padding added to a message when it was
+// split into shares, using the size declared by its MsgPayForMessage
+func TrimMessagePadding(padded []byte, size uint64) ([]byte, error) {
+	if uint64(len(padded)) < size {
+		return nil, fmt.Errorf("padded message is shorter than its declared size: %d < %d", len(padded), size)
 	}
-
-	shareCount := (len(msg) / ShareSize) + 1
-
-	padded := make([]byte, shareCount*ShareSize)
-	copy(padded, msg)
-	return padded
+	for _, b := range padded[size:] {
+		if b != 0 {
+			return nil, fmt.Errorf("message padding contains non-zero bytes")
+		}
+	}
+	return padded[:size], nil
 }
 
 // powerOf2MountainRange returns the heights of the subtrees for binary merkle
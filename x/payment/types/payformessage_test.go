@@ -11,6 +11,7 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/pkg/consts"
 )
 
@@ -127,7 +128,7 @@ func TestCreateCommitment(t *testing.T) {
 			k:         4,
 			namespace: bytes.Repeat([]byte{0xFF}, 8),
 			message:   bytes.Repeat([]byte{0xFF}, 11*ShareSize),
-			expected:  []byte{0x1c, 0x57, 0x89, 0x2f, 0xbe, 0xbf, 0xa2, 0xa4, 0x4c, 0x41, 0x9e, 0x2d, 0x88, 0xd5, 0x87, 0xc0, 0xbd, 0x37, 0xc0, 0x85, 0xbd, 0x10, 0x3c, 0x36, 0xd9, 0xa2, 0x4d, 0x4e, 0x31, 0xa2, 0xf8, 0x4e},
+			expected:  []byte{0xf2, 0xd4, 0xfc, 0x39, 0x4e, 0xf3, 0x97, 0x9d, 0xf4, 0x4c, 0x99, 0x87, 0x36, 0x7d, 0x7d, 0x04, 0xf2, 0xa7, 0x89, 0x26, 0x6d, 0xf5, 0x78, 0xe1, 0xff, 0x72, 0xb4, 0x75, 0x12, 0x1e, 0x71, 0xc3},
 		},
 		{
 			k:         2,
@@ -147,33 +148,65 @@ func TestCreateCommitment(t *testing.T) {
 	}
 }
 
-func TestPadMessage(t *testing.T) {
+func TestCommitmentSubtrees(t *testing.T) {
+	namespace := bytes.Repeat([]byte{0xFF}, 8)
+	message := bytes.Repeat([]byte{0xFF}, 11*ShareSize)
+
+	subtrees, err := CommitmentSubtrees(4, namespace, message)
+	require.NoError(t, err)
+
+	// the subtrees follow the merkle mountain range of the message's shares
+	roots := make([][]byte, len(subtrees))
+	widths := make([]uint64, len(subtrees))
+	for i, subtree := range subtrees {
+		roots[i] = subtree.Root
+		widths[i] = subtree.Width
+	}
+	assert.Equal(t, powerOf2MountainRange(MessageSharesUsed(len(message)), 4), widths)
+
+	commit, err := CreateCommitment(4, namespace, message)
+	require.NoError(t, err)
+	assert.Equal(t, commit, merkle.HashFromByteSlices(roots))
+}
+
+func TestTrimMessagePadding(t *testing.T) {
 	type test struct {
-		input    []byte
+		padded   []byte
+		size     uint64
 		expected []byte
 	}
 	tests := []test{
 		{
-			input:    []byte{1},
-			expected: append([]byte{1}, bytes.Repeat([]byte{0}, ShareSize-1)...),
+			padded:   append([]byte{1}, bytes.Repeat([]byte{0}, ShareSize-1)...),
+			size:     1,
+			expected: []byte{1},
 		},
 		{
-			input:    []byte{},
+			padded:   []byte{},
+			size:     0,
 			expected: []byte{},
 		},
 		{
-			input:    bytes.Repeat([]byte{1}, ShareSize),
+			padded:   bytes.Repeat([]byte{1}, ShareSize),
+			size:     ShareSize,
 			expected: bytes.Repeat([]byte{1}, ShareSize),
 		},
 		{
-			input:    bytes.Repeat([]byte{1}, (3*ShareSize)-10),
-			expected: append(bytes.Repeat([]byte{1}, (3*ShareSize)-10), bytes.Repeat([]byte{0}, 10)...),
+			padded:   append(bytes.Repeat([]byte{1}, (3*ShareSize)-10), bytes.Repeat([]byte{0}, 10)...),
+			size:     (3 * ShareSize) - 10,
+			expected: bytes.Repeat([]byte{1}, (3*ShareSize)-10),
 		},
 	}
 	for _, tt := range tests {
-		res := padMessage(tt.input)
-		assert.Equal(t, tt.expected, res)
+		trimmed, err := TrimMessagePadding(tt.padded, tt.size)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, trimmed)
 	}
+
+	_, err := TrimMessagePadding([]byte{1}, 2)
+	assert.Error(t, err)
+	_, err = TrimMessagePadding([]byte{1, 1}, 1)
+	assert.Error(t, err)
 }
 
 // TestSignMalleatedTxs checks to see that the signatures that are generated for
@@ -276,7 +309,7 @@ func TestWirePayForMessage_ValidateBasic(t *testing.T) {
 	reservedMsg := validWirePayForMessage(t)
 	reservedMsg.MessageNameSpaceId = []byte{0, 0, 0, 0, 0, 0, 0, 100}
 
-	// pfm that has a wrong msg size
+	// pfm whose message was changed after the size was declared
 	invalidMsgSizeMsg := validWirePayForMessage(t)
 	invalidMsgSizeMsg.Message = bytes.Repeat([]byte{1}, consts.ShareSize-20)
 
@@ -311,13 +344,13 @@ func TestWirePayForMessage_ValidateBasic(t *testing.T) {
 			name:      "reserved ns id",
 			msg:       reservedMsg,
 			expectErr: true,
-			errStr:    "uses a reserved namesapce ID",
+			errStr:    "uses a reserved namespace ID",
 		},
 		{
 			name:      "invalid msg size",
 			msg:       invalidMsgSizeMsg,
 			expectErr: true,
-			errStr:    "Share message must be divisible",
+			errStr:    "Declared Message size does not match actual Message size",
 		},
 		{
 			name:      "bad declared message size",
@@ -356,6 +389,61 @@ func TestWirePayForMessage_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestWirePayForMessage_ValidateWithParams(t *testing.T) {
+	type test struct {
+		name      string
+		params    Params
+		expectErr bool
+		errStr    string
+	}
+
+	msg := validWirePayForMessage(t)
+
+	smallMaxBytes := DefaultParams()
+	smallMaxBytes.MaxMessageBytes = msg.MessageSize - 1
+
+	highReservedNamespace := DefaultParams()
+	highReservedNamespace.MaxReservedNamespace = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	otherSquareSizes := DefaultParams()
+	otherSquareSizes.SquareSizes = []uint64{8, 128}
+
+	tests := []test{
+		{
+			name:   "default params",
+			params: DefaultParams(),
+		},
+		{
+			name:      "message too large",
+			params:    smallMaxBytes,
+			expectErr: true,
+			errStr:    "exceeds the max message size",
+		},
+		{
+			name:      "reserved ns id",
+			params:    highReservedNamespace,
+			expectErr: true,
+			errStr:    "uses a reserved namespace ID",
+		},
+		{
+			name:      "no allowed square size",
+			params:    otherSquareSizes,
+			expectErr: true,
+			errStr:    "does not commit to any of the square sizes",
+		},
+	}
+
+	for _, tt := range tests {
+		err := msg.ValidateWithParams(tt.params)
+		if tt.expectErr {
+			require.NotNil(t, err, tt.name)
+			require.Contains(t, err.Error(), tt.errStr, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+	}
+}
+
 func TestProcessMessage(t *testing.T) {
 	type test struct {
 		name      string
@@ -377,21 +465,21 @@ func TestProcessMessage(t *testing.T) {
 		{
 			name:   "single share square size 2",
 			ns:     []byte{1, 1, 1, 1, 1, 1, 1, 1},
-			msg:    bytes.Repeat([]byte{1}, ShareSize),
+			msg:    bytes.Repeat([]byte{1}, int(maxMessageSize(1))),
 			ss:     2,
 			modify: dontModify,
 		},
 		{
 			name:   "15 shares square size 4",
 			ns:     []byte{1, 1, 1, 1, 1, 1, 1, 2},
-			msg:    bytes.Repeat([]byte{2}, ShareSize*15),
+			msg:    bytes.Repeat([]byte{2}, int(maxMessageSize(15))),
 			ss:     4,
 			modify: dontModify,
 		},
 		{
 			name: "",
 			ns:   []byte{1, 1, 1, 1, 1, 1, 1, 2},
-			msg:  bytes.Repeat([]byte{2}, ShareSize*15),
+			msg:  bytes.Repeat([]byte{2}, int(maxMessageSize(15))),
 			ss:   4,
 			modify: func(wpfm *MsgWirePayForMessage) *MsgWirePayForMessage {
 				wpfm.MessageShareCommitment[0].K = 99999
@@ -421,7 +509,8 @@ func TestProcessMessage(t *testing.T) {
 		assert.Equal(t, wpfm.Signer, spfm.Signer, tt.name)
 		assert.Equal(t, wpfm.MessageNameSpaceId, spfm.MessageNamespaceId, tt.name)
 		assert.Equal(t, wpfm.MessageShareCommitment[0].ShareCommitment, spfm.MessageShareCommitment, tt.name)
-		assert.Equal(t, wpfm.MessageShareCommitment[0].Signature, sig, tt.name)
+		assert.Equal(t, wpfm.MessageShareCommitment[0].Signature, sig.Signature, tt.name)
+		assert.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, sig.SignMode, tt.name)
 	}
 }
 
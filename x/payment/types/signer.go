@@ -0,0 +1,136 @@
+package types
+
+import (
+	"context"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ Signer = &KeyringSigner{}
+
+// Signer signs celestia-app transactions on behalf of a single account. It is
+// implemented by the KeyringSigner, and by signers whose keys are kept
+// elsewhere, such as in a remote signing service.
+type Signer interface {
+	// GetAddress returns the address of the signing account
+	GetAddress() sdk.AccAddress
+	// GetPubKey returns the public key of the signing account
+	GetPubKey() (cryptotypes.PubKey, error)
+	// SignBytes signs the provided bytes using the account's private key
+	SignBytes(bz []byte) ([]byte, error)
+	// NewTxBuilder returns a builder for the transactions built by the signer
+	NewTxBuilder() sdkclient.TxBuilder
+	// BuildSignedTx creates and signs a transaction that contains the
+	// provided msgs, using the account number and sequence of the account
+	BuildSignedTx(builder sdkclient.TxBuilder, msgs ...sdk.Msg) (authsigning.Tx, error)
+}
+
+// ContextSigner is implemented by signers that can stop signing once the
+// caller's context is done, such as signers that wait for a remote signing
+// service
+type ContextSigner interface {
+	// SignBytesContext signs the provided bytes using the account's private
+	// key, giving up once the context is done
+	SignBytesContext(ctx context.Context, bz []byte) ([]byte, error)
+}
+
+// SignTx sets the msgs in the builder and signs the transaction with the signer
+// using the provided sign mode. The signer data must contain the chain ID,
+// account number, and sequence of the signing account.
+func SignTx(
+	signer Signer,
+	txConfig sdkclient.TxConfig,
+	signMode signing.SignMode,
+	signerData authsigning.SignerData,
+	builder sdkclient.TxBuilder,
+	msgs ...sdk.Msg,
+) (authsigning.Tx, error) {
+	// set the msgs
+	err := builder.SetMsgs(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := signer.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// we must first set an empty signature in order generate
+	// the correct sign bytes
+	sigV2 := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: nil,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	// set the empty signature
+	err = builder.SetSignatures(sigV2)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate the bytes to be signed.
+	bytesToSign, err := txConfig.SignModeHandler().GetSignBytes(
+		signMode,
+		signerData,
+		builder.GetTx(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := signer.SignBytes(bytesToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the SignatureV2 struct, this time including a real signature
+	sigV2 = signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sigBytes,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	// set the final signature
+	err = builder.SetSignatures(sigV2)
+	if err != nil {
+		return nil, err
+	}
+
+	// return the signed transaction
+	return builder.GetTx(), nil
+}
+
+// CommitmentSignMode returns the sign mode used to create a signature included
+// in a MsgWirePayForMessage or MsgWirePayForMessages. Signatures created
+// before the sign mode was included in the wire messages leave it unspecified,
+// and are always created using SIGN_MODE_DIRECT.
+func CommitmentSignMode(mode signing.SignMode) signing.SignMode {
+	if mode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		return signing.SignMode_SIGN_MODE_DIRECT
+	}
+	return mode
+}
+
+// ValidateCommitmentSignMode returns an error if the sign mode can't be used
+// to sign the transaction that a wire message is malleated into
+func ValidateCommitmentSignMode(mode signing.SignMode) error {
+	switch CommitmentSignMode(mode) {
+	case signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrUnsupportedSignMode, "%s", mode)
+	}
+}
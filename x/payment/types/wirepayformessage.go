@@ -18,9 +18,9 @@ var _ sdk.Msg = &MsgWirePayForMessage{}
 // NewWirePayForMessage creates a new MsgWirePayForMessage by using the
 // namespace and message to generate share commitments for the provided square sizes
 // Note that the share commitments generated still need to be signed using the SignShareCommitments
-// method.
+// method. The message is not padded, so MessageSize is the exact length of the
+// message, while the commitments are generated over the padded share layout.
 func NewWirePayForMessage(namespace, message []byte, sizes ...uint64) (*MsgWirePayForMessage, error) {
-	message = padMessage(message)
 	out := &MsgWirePayForMessage{
 		MessageNameSpaceId:     namespace,
 		MessageSize:            uint64(len(message)),
@@ -44,8 +44,8 @@ func NewWirePayForMessage(namespace, message []byte, sizes ...uint64) (*MsgWireP
 
 // SignShareCommitments creates and signs MsgPayForMessages for each square size configured in the MsgWirePayForMessage
 // to complete each shares commitment.
-func (msg *MsgWirePayForMessage) SignShareCommitments(signer *KeyringSigner, options ...TxBuilderOption) error {
-	msg.Signer = signer.GetSignerInfo().GetAddress().String()
+func (msg *MsgWirePayForMessage) SignShareCommitments(signer Signer, options ...TxBuilderOption) error {
+	msg.Signer = signer.GetAddress().String()
 	// create an entire MsgPayForMessage and signing over it, including the signature in each commitment
 	for i, commit := range msg.MessageShareCommitment {
 		builder := signer.NewTxBuilder()
@@ -58,13 +58,17 @@ func (msg *MsgWirePayForMessage) SignShareCommitments(signer *KeyringSigner, opt
 		if err != nil {
 			return err
 		}
-		msg.MessageShareCommitment[i].Signature = sig
+		msg.MessageShareCommitment[i].Signature = sig.Signature
+		msg.MessageShareCommitment[i].SignMode = sig.SignMode
 	}
 	return nil
 }
 
 func (msg *MsgWirePayForMessage) Route() string { return RouterKey }
 
+// Type fullfills the sdk.Msg interface
+func (msg *MsgWirePayForMessage) Type() string { return URLMsgWirePayforMessage }
+
 // ValidateBasic checks for valid namespace length, declared message size, share
 // commitments, signatures for those share commitments, and fulfills the sdk.Msg
 // interface
@@ -83,11 +87,6 @@ func (msg *MsgWirePayForMessage) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
 	}
 
-	// ensure that the included message is evenly divisble into shares
-	if msgMod := uint64(len(msg.GetMessage())) % ShareSize; msgMod != 0 {
-		return fmt.Errorf("Share message must be divisible by %d", ShareSize)
-	}
-
 	// make sure that the message size matches the actual size of the message
 	if msg.MessageSize != uint64(len(msg.Message)) {
 		return fmt.Errorf(
@@ -99,7 +98,7 @@ func (msg *MsgWirePayForMessage) ValidateBasic() error {
 
 	// ensure that a reserved namespace is not used
 	if bytes.Compare(msg.GetMessageNameSpaceId(), consts.MaxReservedNamespace) < 1 {
-		return errors.New("message is not valid: uses a reserved namesapce ID")
+		return errors.New("message is not valid: uses a reserved namespace ID")
 	}
 
 	for _, commit := range msg.MessageShareCommitment {
@@ -116,16 +115,44 @@ func (msg *MsgWirePayForMessage) ValidateBasic() error {
 		if string(calculatedCommit) != string(commit.ShareCommitment) {
 			return fmt.Errorf("invalid commit for square size %d", commit.K)
 		}
+
+		if err := ValidateCommitmentSignMode(commit.SignMode); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// ValidateWithParams checks the message against the governance controlled
+// parameters of the payment module. Unlike ValidateBasic, the result depends on
+// the current state of the chain.
+func (msg *MsgWirePayForMessage) ValidateWithParams(params Params) error {
+	if msg.MessageSize > params.MaxMessageBytes {
+		return fmt.Errorf(
+			"message size %d exceeds the max message size %d",
+			msg.MessageSize,
+			params.MaxMessageBytes,
+		)
+	}
+
+	if bytes.Compare(msg.GetMessageNameSpaceId(), params.MaxReservedNamespace) < 1 {
+		return errors.New("message is not valid: uses a reserved namespace ID")
+	}
+
+	for _, commit := range msg.MessageShareCommitment {
+		if params.HasSquareSize(commit.K) {
+			return nil
+		}
+	}
+	return fmt.Errorf("message does not commit to any of the square sizes %v", params.SquareSizes)
+}
+
 // GetSignBytes returns the bytes that are expected to be signed for the MsgWirePayForMessage.
 // The signature of these bytes will never actually get included on chain. Note: instead the
 // signature in the ShareCommitAndSignature of the appropriate square size is used
 func (msg *MsgWirePayForMessage) GetSignBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
 
 // GetSigners returns the addresses of the message signers
@@ -138,8 +165,8 @@ func (msg *MsgWirePayForMessage) GetSigners() []sdk.AccAddress {
 }
 
 // createPayForMessageSignature generates the signature for a PayForMessage for a single square
-// size using the info from a MsgWirePayForMessage
-func (msg *MsgWirePayForMessage) createPayForMessageSignature(signer *KeyringSigner, builder sdkclient.TxBuilder, k uint64) ([]byte, error) {
+// size using the info from a MsgWirePayForMessage, along with the sign mode used by the signer
+func (msg *MsgWirePayForMessage) createPayForMessageSignature(signer Signer, builder sdkclient.TxBuilder, k uint64) (*signing.SingleSignatureData, error) {
 	pfm, err := msg.unsignedPayForMessage(k)
 	if err != nil {
 		return nil, err
@@ -159,13 +186,13 @@ func (msg *MsgWirePayForMessage) createPayForMessageSignature(signer *KeyringSig
 	if !ok {
 		return nil, fmt.Errorf("expected a single signer")
 	}
-	return sig.Signature, nil
+	return sig, nil
 }
 
 // unsignedPayForMessage use the data in the MsgWirePayForMessage
 // to create a new MsgPayForMessage.
 func (msg *MsgWirePayForMessage) unsignedPayForMessage(k uint64) (*MsgPayForMessage, error) {
-	// create the commitment using the padded message
+	// create the commitment, which pads the message
 	commit, err := CreateCommitment(k, msg.MessageNameSpaceId, msg.Message)
 	if err != nil {
 		return nil, err
@@ -182,14 +209,14 @@ func (msg *MsgWirePayForMessage) unsignedPayForMessage(k uint64) (*MsgPayForMess
 
 // ProcessWirePayForMessage will perform the processing required by PreProcessTxs.
 // It parses the MsgWirePayForMessage to produce the components needed to create a
-// single  MsgPayForMessage
-func ProcessWirePayForMessage(msg *MsgWirePayForMessage, squareSize uint64) (*tmproto.Message, *MsgPayForMessage, []byte, error) {
+// single  MsgPayForMessage, along with the signature for the square size
+func ProcessWirePayForMessage(msg *MsgWirePayForMessage, squareSize uint64) (*tmproto.Message, *MsgPayForMessage, *signing.SingleSignatureData, error) {
 	// make sure that a ShareCommitAndSignature of the correct size is
 	// included in the message
 	var shareCommit *ShareCommitAndSignature
-	for _, commit := range msg.MessageShareCommitment {
+	for i, commit := range msg.MessageShareCommitment {
 		if commit.K == squareSize {
-			shareCommit = &commit
+			shareCommit = &msg.MessageShareCommitment[i]
 		}
 	}
 	if shareCommit == nil {
@@ -211,5 +238,10 @@ func ProcessWirePayForMessage(msg *MsgWirePayForMessage, squareSize uint64) (*tm
 		return nil, nil, nil, err
 	}
 
-	return &coreMsg, pfm, shareCommit.Signature, nil
+	sig := &signing.SingleSignatureData{
+		SignMode:  CommitmentSignMode(shareCommit.SignMode),
+		Signature: shareCommit.Signature,
+	}
+
+	return &coreMsg, pfm, sig, nil
 }
\ No newline at end of file
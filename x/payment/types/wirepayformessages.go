@@ -0,0 +1,366 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/tendermint/tendermint/pkg/consts"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+var _ sdk.Msg = &MsgWirePayForMessages{}
+
+// NewWirePayForMessages creates a new MsgWirePayForMessages that pays for each
+// message in its corresponding namespace. Every message commits to each of the
+// provided square sizes. Note that the resulting MsgWirePayForMessages still
+// needs to be signed using the SignShareCommitments method.
+func NewWirePayForMessages(namespaces, messages [][]byte, sizes ...uint64) (*MsgWirePayForMessages, error) {
+	if len(namespaces) != len(messages) {
+		return nil, fmt.Errorf(
+			"number of namespaces and messages must match: %d vs %d",
+			len(namespaces),
+			len(messages),
+		)
+	}
+
+	out := &MsgWirePayForMessages{
+		Messages:   make([]WireMessage, len(messages)),
+		Signatures: make([]SquareSizeSignature, len(sizes)),
+	}
+
+	for i := range messages {
+		wireMsg, err := NewWireMessage(namespaces[i], messages[i], sizes...)
+		if err != nil {
+			return nil, err
+		}
+		out.Messages[i] = *wireMsg
+	}
+
+	for i, size := range sizes {
+		out.Signatures[i] = SquareSizeSignature{K: size}
+	}
+	return out, nil
+}
+
+// NewWireMessage creates a single WireMessage by generating the message's
+// share commitments for the provided square sizes. The message is not padded.
+func NewWireMessage(namespace, message []byte, sizes ...uint64) (*WireMessage, error) {
+	out := &WireMessage{
+		NamespaceId:      namespace,
+		MessageSize:      uint64(len(message)),
+		Message:          message,
+		ShareCommitments: make([]ShareCommitment, len(sizes)),
+	}
+
+	// generate the share commitments
+	for i, size := range sizes {
+		if !powerOf2(size) {
+			return nil, fmt.Errorf("Invalid square size, the size must be power of 2: %d", size)
+		}
+		commit, err := CreateCommitment(size, namespace, message)
+		if err != nil {
+			return nil, err
+		}
+		out.ShareCommitments[i] = ShareCommitment{K: size, ShareCommitment: commit}
+	}
+	return out, nil
+}
+
+// SignShareCommitments creates and signs a transaction containing a
+// MsgPayForMessage for every message, once for each square size configured in
+// the MsgWirePayForMessages.
+func (msg *MsgWirePayForMessages) SignShareCommitments(signer Signer, options ...TxBuilderOption) error {
+	msg.Signer = signer.GetAddress().String()
+	for i, sig := range msg.Signatures {
+		builder := signer.NewTxBuilder()
+
+		for _, option := range options {
+			builder = option(builder)
+		}
+
+		sigData, err := msg.createPayForMessagesSignature(signer, builder, sig.K)
+		if err != nil {
+			return err
+		}
+		msg.Signatures[i].Signature = sigData.Signature
+		msg.Signatures[i].SignMode = sigData.SignMode
+	}
+	return nil
+}
+
+// Route fullfills the sdk.Msg interface
+func (msg *MsgWirePayForMessages) Route() string { return RouterKey }
+
+// Type fullfills the sdk.Msg interface
+func (msg *MsgWirePayForMessages) Type() string {
+	return URLMsgWirePayForMessages
+}
+
+// ValidateBasic checks that the signer is valid, that the signed square sizes
+// are valid, and that every message is valid and commits to each of the signed
+// square sizes. It fulfills the sdk.Msg interface
+func (msg *MsgWirePayForMessages) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	if len(msg.Messages) == 0 {
+		return errors.New("at least one message is required")
+	}
+
+	if len(msg.Signatures) == 0 {
+		return errors.New("at least one square size is required")
+	}
+
+	signedSizes := make(map[uint64]bool, len(msg.Signatures))
+	for _, sig := range msg.Signatures {
+		if !powerOf2(sig.K) {
+			return fmt.Errorf("invalid square size, the size must be power of 2: %d", sig.K)
+		}
+		if signedSizes[sig.K] {
+			return fmt.Errorf("duplicate signature for square size %d", sig.K)
+		}
+		if err := ValidateCommitmentSignMode(sig.SignMode); err != nil {
+			return err
+		}
+		signedSizes[sig.K] = true
+	}
+
+	for i, wireMsg := range msg.Messages {
+		if err := wireMsg.validateBasic(signedSizes); err != nil {
+			return fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateBasic checks for valid namespace length, declared message size, and
+// that the message has a valid commitment for each of the signed square sizes
+func (wireMsg WireMessage) validateBasic(signedSizes map[uint64]bool) error {
+	// ensure that the namespace id is of length == NamespaceIDSize
+	if nsLen := len(wireMsg.NamespaceId); nsLen != NamespaceIDSize {
+		return fmt.Errorf(
+			"invalid namespace length: got %d wanted %d",
+			nsLen,
+			NamespaceIDSize,
+		)
+	}
+
+	// make sure that the message size matches the actual size of the message
+	if wireMsg.MessageSize != uint64(len(wireMsg.Message)) {
+		return fmt.Errorf(
+			"Declared Message size does not match actual Message size, %d vs %d",
+			wireMsg.MessageSize,
+			len(wireMsg.Message),
+		)
+	}
+
+	// ensure that a reserved namespace is not used
+	if bytes.Compare(wireMsg.NamespaceId, consts.MaxReservedNamespace) < 1 {
+		return errors.New("message is not valid: uses a reserved namespace ID")
+	}
+
+	if len(wireMsg.ShareCommitments) != len(signedSizes) {
+		return fmt.Errorf(
+			"message must commit to each of the %d signed square sizes: got %d commitments",
+			len(signedSizes),
+			len(wireMsg.ShareCommitments),
+		)
+	}
+
+	committed := make(map[uint64]bool, len(wireMsg.ShareCommitments))
+	for _, commit := range wireMsg.ShareCommitments {
+		if !signedSizes[commit.K] || committed[commit.K] {
+			return fmt.Errorf("unexpected commit for square size %d", commit.K)
+		}
+		committed[commit.K] = true
+
+		calculatedCommit, err := CreateCommitment(commit.K, wireMsg.NamespaceId, wireMsg.Message)
+		if err != nil {
+			return err
+		}
+
+		if string(calculatedCommit) != string(commit.ShareCommitment) {
+			return fmt.Errorf("invalid commit for square size %d", commit.K)
+		}
+	}
+
+	return nil
+}
+
+// ValidateWithParams checks the messages against the governance controlled
+// parameters of the payment module. Unlike ValidateBasic, the result depends on
+// the current state of the chain.
+func (msg *MsgWirePayForMessages) ValidateWithParams(params Params) error {
+	for _, wireMsg := range msg.Messages {
+		if wireMsg.MessageSize > params.MaxMessageBytes {
+			return fmt.Errorf(
+				"message size %d exceeds the max message size %d",
+				wireMsg.MessageSize,
+				params.MaxMessageBytes,
+			)
+		}
+
+		if bytes.Compare(wireMsg.NamespaceId, params.MaxReservedNamespace) < 1 {
+			return errors.New("message is not valid: uses a reserved namespace ID")
+		}
+	}
+
+	for _, sig := range msg.Signatures {
+		if params.HasSquareSize(sig.K) {
+			return nil
+		}
+	}
+	return fmt.Errorf("messages do not commit to any of the square sizes %v", params.SquareSizes)
+}
+
+// GetSignBytes returns the bytes that are expected to be signed for the
+// MsgWirePayForMessages. The signature of these bytes will never actually get
+// included on chain. Note: instead the signature in the SquareSizeSignature of
+// the appropriate square size is used
+func (msg *MsgWirePayForMessages) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the addresses of the message signers
+func (msg *MsgWirePayForMessages) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+// createPayForMessagesSignature generates the signature for a transaction
+// containing a MsgPayForMessage for every message for a single square size,
+// along with the sign mode used by the signer
+func (msg *MsgWirePayForMessages) createPayForMessagesSignature(signer Signer, builder sdkclient.TxBuilder, k uint64) (*signing.SingleSignatureData, error) {
+	pfms, err := msg.unsignedPayForMessages(k)
+	if err != nil {
+		return nil, err
+	}
+	sdkMsgs := make([]sdk.Msg, len(pfms))
+	for i, pfm := range pfms {
+		sdkMsgs[i] = pfm
+	}
+	tx, err := signer.BuildSignedTx(builder, sdkMsgs...)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, fmt.Errorf("expected a single signer: got %d", len(sigs))
+	}
+	sig, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	if !ok {
+		return nil, fmt.Errorf("expected a single signer")
+	}
+	return sig, nil
+}
+
+// unsignedPayForMessages uses the data in the MsgWirePayForMessages to create
+// a MsgPayForMessage for every message
+func (msg *MsgWirePayForMessages) unsignedPayForMessages(k uint64) ([]*MsgPayForMessage, error) {
+	pfms := make([]*MsgPayForMessage, len(msg.Messages))
+	for i, wireMsg := range msg.Messages {
+		// create the commitment, which pads the message
+		commit, err := CreateCommitment(k, wireMsg.NamespaceId, wireMsg.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		pfms[i] = &MsgPayForMessage{
+			MessageNamespaceId:     wireMsg.NamespaceId,
+			MessageSize:            wireMsg.MessageSize,
+			MessageShareCommitment: commit,
+			Signer:                 msg.Signer,
+		}
+	}
+	return pfms, nil
+}
+
+// ProcessWirePayForMessages will perform the processing required by
+// PreProcessTxs. It parses the MsgWirePayForMessages to produce a core message
+// and a MsgPayForMessage for each message, along with the signature over the
+// transaction containing all of the MsgPayForMessages.
+func ProcessWirePayForMessages(msg *MsgWirePayForMessages, squareSize uint64) ([]*tmproto.Message, []*MsgPayForMessage, *signing.SingleSignatureData, error) {
+	// make sure that a signature for the correct size is included in the
+	// message
+	var signature *signing.SingleSignatureData
+	for _, sig := range msg.Signatures {
+		if sig.K == squareSize {
+			signature = &signing.SingleSignatureData{
+				SignMode:  CommitmentSignMode(sig.SignMode),
+				Signature: sig.Signature,
+			}
+		}
+	}
+	if signature == nil {
+		return nil,
+			nil,
+			nil,
+			fmt.Errorf("messages do not commit to current square size: %d", squareSize)
+	}
+
+	// add each message to the list of core messages to be returned to ll-core
+	coreMsgs := make([]*tmproto.Message, len(msg.Messages))
+	for i, wireMsg := range msg.Messages {
+		coreMsgs[i] = &tmproto.Message{
+			NamespaceId: wireMsg.NamespaceId,
+			Data:        wireMsg.Message,
+		}
+	}
+
+	pfms, err := msg.unsignedPayForMessages(squareSize)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return coreMsgs, pfms, signature, nil
+}
+
+// ProcessWireMsg parses a MsgWirePayForMessage or MsgWirePayForMessages into
+// the core messages and the unsigned MsgPayForMessages that pay for them,
+// along with the signature for the provided square size
+func ProcessWireMsg(msg sdk.Msg, squareSize uint64) ([]*tmproto.Message, []*MsgPayForMessage, *signing.SingleSignatureData, error) {
+	switch wireMsg := msg.(type) {
+	case *MsgWirePayForMessage:
+		coreMsg, unsignedPFM, sig, err := ProcessWirePayForMessage(wireMsg, squareSize)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return []*tmproto.Message{coreMsg}, []*MsgPayForMessage{unsignedPFM}, sig, nil
+
+	case *MsgWirePayForMessages:
+		return ProcessWirePayForMessages(wireMsg, squareSize)
+
+	default:
+		return nil, nil, nil, fmt.Errorf("unexpected wire message type: %T", msg)
+	}
+}
+
+// WireMsgSquareSizes returns the square sizes that a MsgWirePayForMessage or
+// MsgWirePayForMessages is signed for. Nil is returned for any other sdk.Msg.
+func WireMsgSquareSizes(msg sdk.Msg) []uint64 {
+	var sizes []uint64
+	switch wireMsg := msg.(type) {
+	case *MsgWirePayForMessage:
+		for _, commit := range wireMsg.MessageShareCommitment {
+			sizes = append(sizes, commit.K)
+		}
+	case *MsgWirePayForMessages:
+		for _, sig := range wireMsg.Signatures {
+			sizes = append(sizes, sig.K)
+		}
+	}
+	return sizes
+}
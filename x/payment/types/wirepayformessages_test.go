@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessWirePayForMessages(t *testing.T) {
+	options := []TxBuilderOption{SetGasLimit(2000000)}
+
+	namespaces := [][]byte{{1, 1, 1, 1, 1, 1, 1, 1}, {2, 2, 2, 2, 2, 2, 2, 2}}
+	messages := [][]byte{bytes.Repeat([]byte{1}, 300), bytes.Repeat([]byte{2}, ShareSize*3)}
+
+	for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+		signer := generateKeyringSigner(t)
+		signer.SetSignMode(signMode)
+
+		wpfms, err := NewWirePayForMessages(namespaces, messages, 4, 8, 16)
+		require.NoError(t, err)
+		err = wpfms.SignShareCommitments(signer, options...)
+		require.NoError(t, err)
+		require.NoError(t, wpfms.ValidateBasic())
+
+		wireTx, err := signer.BuildSignedTx(applyOptions(signer.NewTxBuilder(), options...), wpfms)
+		require.NoError(t, err)
+
+		for _, size := range []uint64{4, 8, 16} {
+			coreMsgs, pfms, sig, err := ProcessWirePayForMessages(wpfms, size)
+			require.NoError(t, err)
+			require.Len(t, coreMsgs, 2)
+			require.Len(t, pfms, 2)
+			assert.Equal(t, signMode, sig.SignMode)
+
+			// each message is returned as its own core message
+			for i := range messages {
+				assert.Equal(t, namespaces[i], coreMsgs[i].NamespaceId)
+				assert.Equal(t, messages[i], coreMsgs[i].Data)
+				assert.Equal(t, namespaces[i], pfms[i].MessageNamespaceId)
+				commit, err := CreateCommitment(size, namespaces[i], messages[i])
+				require.NoError(t, err)
+				assert.Equal(t, commit, pfms[i].MessageShareCommitment)
+			}
+
+			// the signature is valid for the single tx that pays for every message
+			malleatedTx, err := BuildPayForMessageTxFromWireTx(wireTx, signer.NewTxBuilder(), sig, pfms...)
+			require.NoError(t, err)
+			assert.Len(t, malleatedTx.GetMsgs(), 2)
+
+			bytesToSign, err := signer.encCfg.TxConfig.SignModeHandler().GetSignBytes(
+				signMode,
+				authsigning.SignerData{
+					ChainID:       signer.chainID,
+					AccountNumber: signer.accountNumber,
+					Sequence:      signer.sequence,
+				},
+				malleatedTx,
+			)
+			require.NoError(t, err)
+			assert.True(t, signer.GetSignerInfo().GetPubKey().VerifySignature(bytesToSign, sig.Signature), size)
+		}
+
+		_, _, _, err = ProcessWirePayForMessages(wpfms, 32)
+		assert.Error(t, err)
+	}
+}
+
+func TestWirePayForMessages_ValidateBasic(t *testing.T) {
+	type test struct {
+		name      string
+		modify    func(msg *MsgWirePayForMessages)
+		expectErr bool
+		errStr    string
+	}
+
+	tests := []test{
+		{
+			name:   "valid msg",
+			modify: func(msg *MsgWirePayForMessages) {},
+		},
+		{
+			name:      "no messages",
+			modify:    func(msg *MsgWirePayForMessages) { msg.Messages = nil },
+			expectErr: true,
+			errStr:    "at least one message is required",
+		},
+		{
+			name:      "duplicate square size",
+			modify:    func(msg *MsgWirePayForMessages) { msg.Signatures[1].K = msg.Signatures[0].K },
+			expectErr: true,
+			errStr:    "duplicate signature for square size",
+		},
+		{
+			name: "missing commitment",
+			modify: func(msg *MsgWirePayForMessages) {
+				msg.Messages[1].ShareCommitments = msg.Messages[1].ShareCommitments[:1]
+			},
+			expectErr: true,
+			errStr:    "must commit to each of the 2 signed square sizes",
+		},
+		{
+			name:      "bad ns ID",
+			modify:    func(msg *MsgWirePayForMessages) { msg.Messages[0].NamespaceId = []byte{1, 2, 3} },
+			expectErr: true,
+			errStr:    "invalid namespace length",
+		},
+		{
+			name:      "reserved ns id",
+			modify:    func(msg *MsgWirePayForMessages) { msg.Messages[1].NamespaceId = []byte{0, 0, 0, 0, 0, 0, 0, 100} },
+			expectErr: true,
+			errStr:    "uses a reserved namespace ID",
+		},
+		{
+			name: "bad commitment",
+			modify: func(msg *MsgWirePayForMessages) {
+				msg.Messages[0].ShareCommitments[0].ShareCommitment = []byte{1, 2, 3, 4}
+			},
+			expectErr: true,
+			errStr:    "invalid commit for square size",
+		},
+		{
+			name: "unsupported sign mode",
+			modify: func(msg *MsgWirePayForMessages) {
+				msg.Signatures[0].SignMode = signing.SignMode_SIGN_MODE_TEXTUAL
+			},
+			expectErr: true,
+			errStr:    "sign mode is not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		msg, err := NewWirePayForMessages(
+			[][]byte{{1, 1, 1, 1, 1, 1, 1, 1}, {2, 2, 2, 2, 2, 2, 2, 2}},
+			[][]byte{{1}, bytes.Repeat([]byte{2}, 600)},
+			16, 32,
+		)
+		require.NoError(t, err)
+		require.NoError(t, msg.SignShareCommitments(generateKeyringSigner(t)))
+		tt.modify(msg)
+
+		err = msg.ValidateBasic()
+		if tt.expectErr {
+			require.NotNil(t, err, tt.name)
+			require.Contains(t, err.Error(), tt.errStr, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+	}
+}
@@ -0,0 +1,33 @@
+package qgb
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker activates the pending registrations that reached their
+// activation height, and then creates a new valset when there is none yet,
+// when a validator began unbonding in this block, or when the normalized power
+// of the bonded validators changed by more than the
+// ValsetPowerChangeThreshold param since the latest valset. It must run after
+// the staking EndBlocker, so that the validator set is up to date.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ActivatePendingEVMRegistrations(ctx)
+
+	current := k.GetCurrentValset(ctx)
+	// a valset without members can't be signed
+	if len(current.Members) == 0 {
+		return
+	}
+
+	latest, found := k.GetLatestValset(ctx)
+	switch {
+	case !found:
+	case k.GetLastUnbondingHeight(ctx) == uint64(ctx.BlockHeight()):
+	case latest.PowerDiff(current).GT(k.ValsetPowerChangeThreshold(ctx)):
+	default:
+		return
+	}
+
+	k.SetValsetRequest(ctx)
+}
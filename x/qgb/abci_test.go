@@ -0,0 +1,136 @@
+package qgb_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/x/qgb"
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// mockStakingKeeper returns a fixed set of bonded validators
+type mockStakingKeeper struct {
+	validators []sdk.ValAddress
+	powers     map[string]int64
+}
+
+func (m *mockStakingKeeper) Validator(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, val := range m.validators {
+		if val.Equals(addr) {
+			return stakingtypes.Validator{OperatorAddress: val.String()}
+		}
+	}
+	return nil
+}
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(_ sdk.Context) []stakingtypes.Validator {
+	validators := make([]stakingtypes.Validator, len(m.validators))
+	for i, val := range m.validators {
+		validators[i] = stakingtypes.Validator{OperatorAddress: val.String()}
+	}
+	return validators
+}
+
+func (m *mockStakingKeeper) GetLastValidatorPower(_ sdk.Context, operator sdk.ValAddress) int64 {
+	return m.powers[operator.String()]
+}
+
+func setupKeeper(t *testing.T, staking keeper.StakingKeeper) (keeper.Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := store.NewCommitMultiStore(tmdb.NewMemDB())
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(memKey, sdk.StoreTypeMemory, nil)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	subspace := paramstypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName)
+	k := keeper.NewKeeper(encCfg.Marshaler, staking, storeKey, memKey, subspace)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return *k, ctx
+}
+
+// register makes a registration of the validator with a random orchestrator
+// active
+func register(ctx sdk.Context, k keeper.Keeper, val sdk.ValAddress, ethAddress string) {
+	k.SetEVMRegistration(ctx, types.EVMRegistration{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		EvmAddress:          ethAddress,
+	})
+}
+
+func TestEndBlockerValsetRequests(t *testing.T) {
+	val1 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	val2 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	staking := &mockStakingKeeper{
+		validators: []sdk.ValAddress{val1, val2},
+		powers:     map[string]int64{val1.String(): 10, val2.String(): 10},
+	}
+	k, ctx := setupKeeper(t, staking)
+
+	// endBlock runs the EndBlocker at the next height and returns the nonce of
+	// the latest valset
+	endBlock := func() uint64 {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		qgb.EndBlocker(ctx, k)
+		return k.GetLatestValsetNonce(ctx)
+	}
+
+	// no valset is created until validators register an Ethereum address
+	assert.Equal(t, uint64(0), endBlock())
+	register(ctx, k, val1, "0x0000000000000000000000000000000000000001")
+	register(ctx, k, val2, "0x0000000000000000000000000000000000000002")
+
+	assert.Equal(t, uint64(1), endBlock())
+	valset, found := k.GetLatestValset(ctx)
+	require.True(t, found)
+	assert.Equal(t, uint64(ctx.BlockHeight()), valset.Height)
+	require.Len(t, valset.Members, 2)
+	assert.Equal(t, types.TotalPower/2, valset.Members[0].Power)
+
+	// the valset doesn't change if the power doesn't change
+	assert.Equal(t, uint64(1), endBlock())
+
+	// a change of less than the threshold doesn't create a new valset
+	staking.powers[val1.String()] = 11
+	assert.Equal(t, uint64(1), endBlock())
+
+	staking.powers[val1.String()] = 12
+	assert.Equal(t, uint64(2), endBlock())
+	valset, found = k.GetValset(ctx, 2)
+	require.True(t, found)
+	assert.Greater(t, valset.Members[0].Power, valset.Members[1].Power)
+
+	// a validator beginning to unbond creates a new valset, even if the
+	// power of the bonded validators didn't change yet
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.Hooks().AfterValidatorBeginUnbonding(ctx, nil, val2)
+	qgb.EndBlocker(ctx, k)
+	assert.Equal(t, uint64(3), k.GetLatestValsetNonce(ctx))
+	assert.Equal(t, uint64(3), endBlock())
+
+	res, err := k.LatestValset(sdk.WrapSDKContext(ctx), &types.QueryLatestValsetRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.Valset.Nonce)
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func CmdRegisterEVMAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-evm-address [orchestrator address] [evm key file]",
+		Short: "Registers the orchestrator and the Ethereum address used by a validator to sign QGB confirms",
+		Long: `Register the orchestrator account and the Ethereum address that the validator
+of the --from account uses to sign QGB confirms. The registration is signed using
+the hex encoded Ethereum private key read from the key file, which proves that
+the validator controls the Ethereum address.
+
+The first registration of a validator is active immediately. Later
+registrations replace the active registration after the EVMAddressRotationDelay
+param.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return errors.New("the chain ID is required to sign the registration")
+			}
+
+			orch, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid orchestrator address: %w", err)
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			keyBz, err := hex.DecodeString(string(bytes.TrimPrefix(bytes.TrimSpace(bz), []byte("0x"))))
+			if err != nil {
+				return fmt.Errorf("failure to decode hex Ethereum key: %w", err)
+			}
+			privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), keyBz)
+
+			val := sdk.ValAddress(clientCtx.GetFromAddress())
+			signature, err := types.SignEthHash(privKey.ToECDSA(), types.RegisterEVMAddressHash(clientCtx.ChainID, val, orch))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterEVMAddress(
+				val,
+				orch,
+				types.EthAddressFromPubKey(&privKey.ToECDSA().PublicKey),
+				hex.EncodeToString(signature),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,104 @@
+package qgb_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/celestiaorg/celestia-app/x/qgb"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterEVMAddress(t *testing.T) {
+	val := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	orch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newOrch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	staking := &mockStakingKeeper{validators: []sdk.ValAddress{val, otherVal}}
+	k, ctx := setupKeeper(t, staking)
+	ctx = ctx.WithChainID("test-chain")
+	handler := qgb.NewHandler(k)
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	newKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	evmAddress := types.EthAddressFromPubKey(&key.ToECDSA().PublicKey)
+	newEVMAddress := types.EthAddressFromPubKey(&newKey.ToECDSA().PublicKey)
+
+	// newMsg returns a registration of the validator signed using the key
+	newMsg := func(val sdk.ValAddress, orch sdk.AccAddress, key *btcec.PrivateKey, chainID string) *types.MsgRegisterEVMAddress {
+		signature, err := types.SignEthHash(key.ToECDSA(), types.RegisterEVMAddressHash(chainID, val, orch))
+		require.NoError(t, err)
+		msg := types.NewMsgRegisterEVMAddress(val, orch, types.EthAddressFromPubKey(&key.ToECDSA().PublicKey), hex.EncodeToString(signature))
+		require.NoError(t, msg.ValidateBasic())
+		return msg
+	}
+
+	// the signature must be made for this chain
+	_, err = handler(ctx, newMsg(val, orch, key, "other-chain"))
+	assert.ErrorIs(t, err, types.ErrInvalidEthSignature)
+
+	// the signature must be made by the registered address
+	msg := newMsg(val, orch, key, "test-chain")
+	msg.EvmAddress = newEVMAddress
+	_, err = handler(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrInvalidEthSignature)
+
+	unknownVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	_, err = handler(ctx, newMsg(unknownVal, orch, key, "test-chain"))
+	assert.ErrorIs(t, err, types.ErrUnknownValidator)
+
+	// the first registration is active immediately
+	_, err = handler(ctx, newMsg(val, orch, key, "test-chain"))
+	require.NoError(t, err)
+	got, found := k.GetOrchestratorValidator(ctx, orch)
+	require.True(t, found)
+	assert.Equal(t, val, got)
+	ethAddress, found := k.GetEthAddress(ctx, val)
+	require.True(t, found)
+	assert.Equal(t, evmAddress, ethAddress)
+
+	// the orchestrator and the Ethereum address can't be used by another
+	// validator
+	_, err = handler(ctx, newMsg(otherVal, orch, newKey, "test-chain"))
+	assert.ErrorIs(t, err, types.ErrOrchestratorAlreadyRegistered)
+	_, err = handler(ctx, newMsg(otherVal, newOrch, key, "test-chain"))
+	assert.ErrorIs(t, err, types.ErrEthAddressAlreadyRegistered)
+
+	// a rotation only becomes active after the delay
+	_, err = handler(ctx, newMsg(val, newOrch, newKey, "test-chain"))
+	require.NoError(t, err)
+	pending, found := k.GetPendingEVMRegistration(ctx, val)
+	require.True(t, found)
+	delay := k.EVMAddressRotationDelay(ctx)
+	assert.Equal(t, uint64(ctx.BlockHeight())+delay, pending.ActivationHeight)
+
+	// the pending registration is reserved for the validator
+	_, err = handler(ctx, newMsg(otherVal, newOrch, newKey, "test-chain"))
+	assert.ErrorIs(t, err, types.ErrOrchestratorAlreadyRegistered)
+
+	activation := ctx.BlockHeight() + int64(delay)
+	qgb.EndBlocker(ctx.WithBlockHeight(activation-1), k)
+	_, found = k.GetOrchestratorValidator(ctx, orch)
+	assert.True(t, found)
+
+	qgb.EndBlocker(ctx.WithBlockHeight(activation), k)
+	_, found = k.GetOrchestratorValidator(ctx, orch)
+	assert.False(t, found)
+	got, found = k.GetOrchestratorValidator(ctx, newOrch)
+	require.True(t, found)
+	assert.Equal(t, val, got)
+	ethAddress, _ = k.GetEthAddress(ctx, val)
+	assert.Equal(t, newEVMAddress, ethAddress)
+	_, found = k.GetPendingEVMRegistration(ctx, val)
+	assert.False(t, found)
+
+	// the previous orchestrator and Ethereum address are free again
+	_, err = handler(ctx, newMsg(otherVal, orch, key, "test-chain"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the current parameters of the qgb module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// LatestValset returns the most recently created valset
+func (k Keeper) LatestValset(c context.Context, req *types.QueryLatestValsetRequest) (*types.QueryLatestValsetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valset, found := k.GetLatestValset(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no valset has been created")
+	}
+
+	return &types.QueryLatestValsetResponse{Valset: &valset}, nil
+}
+
+// Valset returns the valset created with the nonce
+func (k Keeper) Valset(c context.Context, req *types.QueryValsetRequest) (*types.QueryValsetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valset, found := k.GetValset(ctx, req.Nonce)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no valset with nonce %d", req.Nonce)
+	}
+
+	return &types.QueryValsetResponse{Valset: &valset}, nil
+}
+
+// EVMRegistration returns the active and pending registrations of a validator
+func (k Keeper) EVMRegistration(c context.Context, req *types.QueryEVMRegistrationRequest) (*types.QueryEVMRegistrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var res types.QueryEVMRegistrationResponse
+	if active, found := k.GetEVMRegistration(ctx, val); found {
+		res.Active = &active
+	}
+	if pending, found := k.GetPendingEVMRegistration(ctx, val); found {
+		res.Pending = &pending
+	}
+	if res.Active == nil && res.Pending == nil {
+		return nil, status.Errorf(codes.NotFound, "%s is not registered", req.ValidatorAddress)
+	}
+
+	return &res, nil
+}
@@ -2,22 +2,82 @@ package keeper
 
 import (
 	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetDataCommitmentConfirm
-func (k Keeper) GetDataCommitmentConfirm(ctx sdk.Context) *types.MsgDataCommitmentConfirm {
-	// TODO
-	return nil
+// GetDataCommitmentConfirm returns the confirm of the data commitment up to
+// the end block submitted by the orchestrator, or nil if it hasn't confirmed
+// it
+func (k Keeper) GetDataCommitmentConfirm(
+	ctx sdk.Context,
+	endBlock uint64,
+	commitment []byte,
+	orchestrator sdk.AccAddress,
+) *types.MsgDataCommitmentConfirm {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DataCommitmentConfirmKeyPrefix))
+
+	b := store.Get(types.DataCommitmentConfirmKey(endBlock, commitment, orchestrator))
+	if b == nil {
+		return nil
+	}
+
+	var confirm types.MsgDataCommitmentConfirm
+	k.cdc.MustUnmarshal(b, &confirm)
+	return &confirm
 }
 
-// SetDataCommitmentConfirm
+// SetDataCommitmentConfirm stores a data commitment confirm and returns its
+// store key
 func (k Keeper) SetDataCommitmentConfirm(ctx sdk.Context, dcConf types.MsgDataCommitmentConfirm) []byte {
-	// TODO
-	return nil
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DataCommitmentConfirmKeyPrefix))
+
+	commitment, err := types.DecodeCommitment(dcConf.Commitment)
+	if err != nil {
+		panic(err)
+	}
+	orchestrator, err := sdk.AccAddressFromBech32(dcConf.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	key := types.DataCommitmentConfirmKey(dcConf.EndBlock, commitment, orchestrator)
+	store.Set(key, k.cdc.MustMarshal(&dcConf))
+	return key
 }
 
-// DeleteDataCommitmentConfirms
-func (k Keeper) DeleteDataCommitmentConfirms(ctx sdk.Context) {
-	// TODO
+// GetDataCommitmentConfirms returns every confirm of the data commitment up to
+// the end block
+func (k Keeper) GetDataCommitmentConfirms(ctx sdk.Context, endBlock uint64, commitment []byte) (confirms []types.MsgDataCommitmentConfirm) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DataCommitmentConfirmKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DataCommitmentConfirmCommitmentPrefix(endBlock, commitment))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var confirm types.MsgDataCommitmentConfirm
+		k.cdc.MustUnmarshal(iterator.Value(), &confirm)
+		confirms = append(confirms, confirm)
+	}
+
+	return
+}
+
+// DeleteDataCommitmentConfirms deletes every confirm of the data commitment up
+// to the end block
+func (k Keeper) DeleteDataCommitmentConfirms(ctx sdk.Context, endBlock uint64, commitment []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DataCommitmentConfirmKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DataCommitmentConfirmCommitmentPrefix(endBlock, commitment))
+
+	// collect the keys first, since the store can't be written to while it
+	// is being iterated over
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetEVMRegistration makes the registration the active registration of its
+// validator, replacing the orchestrator and Ethereum address that the
+// validator was registered with
+func (k Keeper) SetEVMRegistration(ctx sdk.Context, reg types.EVMRegistration) {
+	val := mustValAddress(reg.ValidatorAddress)
+
+	if prev, found := k.GetEVMRegistration(ctx, val); found {
+		k.orchestratorStore(ctx).Delete(mustAccAddress(prev.OrchestratorAddress))
+		k.ethAddressStore(ctx).Delete(ethAddressKey(prev.EvmAddress))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EVMRegistrationKeyPrefix))
+	store.Set(val, k.cdc.MustMarshal(&reg))
+	k.orchestratorStore(ctx).Set(mustAccAddress(reg.OrchestratorAddress), val)
+	k.ethAddressStore(ctx).Set(ethAddressKey(reg.EvmAddress), val)
+}
+
+// GetEVMRegistration returns the active registration of the validator
+func (k Keeper) GetEVMRegistration(ctx sdk.Context, val sdk.ValAddress) (reg types.EVMRegistration, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EVMRegistrationKeyPrefix))
+
+	b := store.Get(val)
+	if b == nil {
+		return reg, false
+	}
+
+	k.cdc.MustUnmarshal(b, &reg)
+	return reg, true
+}
+
+// SetPendingEVMRegistration stores a registration that replaces the active
+// registration of its validator at its activation height. It replaces any
+// earlier pending registration of the validator.
+func (k Keeper) SetPendingEVMRegistration(ctx sdk.Context, reg types.EVMRegistration) {
+	val := mustValAddress(reg.ValidatorAddress)
+	k.deletePendingEVMRegistration(ctx, val)
+
+	k.pendingEVMRegistrationStore(ctx).Set(types.PendingEVMRegistrationKey(reg.ActivationHeight, val), k.cdc.MustMarshal(&reg))
+	k.pendingEVMRegistrationHeightStore(ctx).Set(val, sdk.Uint64ToBigEndian(reg.ActivationHeight))
+}
+
+// GetPendingEVMRegistration returns the pending registration of the validator
+func (k Keeper) GetPendingEVMRegistration(ctx sdk.Context, val sdk.ValAddress) (reg types.EVMRegistration, found bool) {
+	height := k.pendingEVMRegistrationHeightStore(ctx).Get(val)
+	if height == nil {
+		return reg, false
+	}
+
+	b := k.pendingEVMRegistrationStore(ctx).Get(types.PendingEVMRegistrationKey(sdk.BigEndianToUint64(height), val))
+	if b == nil {
+		return reg, false
+	}
+
+	k.cdc.MustUnmarshal(b, &reg)
+	return reg, true
+}
+
+// GetAllPendingEVMRegistrations returns every pending registration, ordered by
+// activation height
+func (k Keeper) GetAllPendingEVMRegistrations(ctx sdk.Context) (list []types.EVMRegistration) {
+	iterator := sdk.KVStorePrefixIterator(k.pendingEVMRegistrationStore(ctx), []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reg types.EVMRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &reg)
+		list = append(list, reg)
+	}
+
+	return
+}
+
+// ActivatePendingEVMRegistrations makes the pending registrations that reached
+// their activation height active. Only the registrations up to the current
+// height are iterated over.
+func (k Keeper) ActivatePendingEVMRegistrations(ctx sdk.Context) {
+	end := types.PendingEVMRegistrationHeightPrefix(uint64(ctx.BlockHeight()) + 1)
+	iterator := k.pendingEVMRegistrationStore(ctx).Iterator(nil, end)
+
+	var regs []types.EVMRegistration
+	for ; iterator.Valid(); iterator.Next() {
+		var reg types.EVMRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &reg)
+		regs = append(regs, reg)
+	}
+	iterator.Close()
+
+	for _, reg := range regs {
+		k.deletePendingEVMRegistration(ctx, mustValAddress(reg.ValidatorAddress))
+		k.SetEVMRegistration(ctx, reg)
+
+		ctx.EventManager().EmitEvent(
+			types.NewActivateEVMRegistrationEvent(reg),
+		)
+	}
+}
+
+// deletePendingEVMRegistration deletes the pending registration of the
+// validator, if any
+func (k Keeper) deletePendingEVMRegistration(ctx sdk.Context, val sdk.ValAddress) {
+	heights := k.pendingEVMRegistrationHeightStore(ctx)
+	height := heights.Get(val)
+	if height == nil {
+		return
+	}
+
+	k.pendingEVMRegistrationStore(ctx).Delete(types.PendingEVMRegistrationKey(sdk.BigEndianToUint64(height), val))
+	heights.Delete(val)
+}
+
+// GetOrchestratorValidator returns the validator that the orchestrator account
+// signs qgb confirms for
+func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (sdk.ValAddress, bool) {
+	b := k.orchestratorStore(ctx).Get(orch)
+	if b == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(b), true
+}
+
+// GetEthAddressValidator returns the validator that the Ethereum address signs
+// qgb confirms for
+func (k Keeper) GetEthAddressValidator(ctx sdk.Context, ethAddress string) (sdk.ValAddress, bool) {
+	b := k.ethAddressStore(ctx).Get(ethAddressKey(ethAddress))
+	if b == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(b), true
+}
+
+// GetEthAddress returns the Ethereum address that the validator signs qgb
+// confirms with
+func (k Keeper) GetEthAddress(ctx sdk.Context, val sdk.ValAddress) (string, bool) {
+	reg, found := k.GetEVMRegistration(ctx, val)
+	if !found {
+		return "", false
+	}
+	return reg.EvmAddress, true
+}
+
+// confirmingValidator returns the validator that a confirm submitted by the
+// orchestrator is for, checking that the Ethereum address in the confirm is
+// the one registered for the validator
+func (k Keeper) confirmingValidator(ctx sdk.Context, orchestrator string, ethAddress string) (sdk.ValAddress, error) {
+	orch, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found := k.GetOrchestratorValidator(ctx, orch)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOrchestrator, orchestrator)
+	}
+
+	registered, found := k.GetEthAddress(ctx, val)
+	if !found || !strings.EqualFold(registered, ethAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrEthAddressMismatch, "got %s for validator %s", ethAddress, val)
+	}
+
+	return val, nil
+}
+
+// checkRegistrationConflicts returns an error if the orchestrator or the
+// Ethereum address of the registration is registered, or about to be
+// registered, for another validator
+func (k Keeper) checkRegistrationConflicts(ctx sdk.Context, reg types.EVMRegistration) error {
+	val := mustValAddress(reg.ValidatorAddress)
+
+	if other, found := k.GetOrchestratorValidator(ctx, mustAccAddress(reg.OrchestratorAddress)); found && !other.Equals(val) {
+		return sdkerrors.Wrapf(types.ErrOrchestratorAlreadyRegistered, "%s is registered for %s", reg.OrchestratorAddress, other)
+	}
+	if other, found := k.GetEthAddressValidator(ctx, reg.EvmAddress); found && !other.Equals(val) {
+		return sdkerrors.Wrapf(types.ErrEthAddressAlreadyRegistered, "%s is registered for %s", reg.EvmAddress, other)
+	}
+
+	for _, pending := range k.GetAllPendingEVMRegistrations(ctx) {
+		if pending.ValidatorAddress == reg.ValidatorAddress {
+			continue
+		}
+		if pending.OrchestratorAddress == reg.OrchestratorAddress {
+			return sdkerrors.Wrapf(types.ErrOrchestratorAlreadyRegistered, "%s is pending for %s", reg.OrchestratorAddress, pending.ValidatorAddress)
+		}
+		if strings.EqualFold(pending.EvmAddress, reg.EvmAddress) {
+			return sdkerrors.Wrapf(types.ErrEthAddressAlreadyRegistered, "%s is pending for %s", reg.EvmAddress, pending.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) orchestratorStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OrchestratorKeyPrefix))
+}
+
+func (k Keeper) ethAddressStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EthAddressKeyPrefix))
+}
+
+func (k Keeper) pendingEVMRegistrationStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingEVMRegistrationKeyPrefix))
+}
+
+func (k Keeper) pendingEVMRegistrationHeightStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingEVMRegistrationHeightKeyPrefix))
+}
+
+// ethAddressKey returns the store key of an Ethereum address, which ignores
+// the EIP-55 checksum casing
+func ethAddressKey(ethAddress string) []byte {
+	return []byte(strings.ToLower(ethAddress))
+}
+
+func mustValAddress(addr string) sdk.ValAddress {
+	val, err := sdk.ValAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+func mustAccAddress(addr string) sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return acc
+}
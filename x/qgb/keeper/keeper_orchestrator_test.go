@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestActivatePendingEVMRegistrations(t *testing.T) {
+	newVal := func() sdk.ValAddress { return sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()) }
+	newOrch := func() sdk.AccAddress { return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()) }
+	val, otherVal := newVal(), newVal()
+
+	testApp := testutil.SetupTestApp(t, newOrch())
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	k := testApp.QgbKeeper
+
+	pending := func(val sdk.ValAddress, height uint64) types.EVMRegistration {
+		_, ethAddress := newEthKey(t)
+		register(ctx, k, val, newOrch(), ethAddress)
+		_, ethAddress = newEthKey(t)
+		return types.EVMRegistration{
+			ValidatorAddress:    val.String(),
+			OrchestratorAddress: newOrch().String(),
+			EvmAddress:          ethAddress,
+			ActivationHeight:    height,
+		}
+	}
+
+	reg := pending(val, 20)
+	otherReg := pending(otherVal, 10)
+	k.SetPendingEVMRegistration(ctx, reg)
+	k.SetPendingEVMRegistration(ctx, otherReg)
+
+	// pending registrations are ordered by activation height
+	assert.Equal(t, []types.EVMRegistration{otherReg, reg}, k.GetAllPendingEVMRegistrations(ctx))
+
+	// a new pending registration replaces the earlier one of the validator
+	reg.ActivationHeight = 30
+	k.SetPendingEVMRegistration(ctx, reg)
+	assert.Equal(t, []types.EVMRegistration{otherReg, reg}, k.GetAllPendingEVMRegistrations(ctx))
+	got, found := k.GetPendingEVMRegistration(ctx, val)
+	require.True(t, found)
+	assert.Equal(t, reg, got)
+
+	// only the registrations up to the current height are activated
+	k.ActivatePendingEVMRegistrations(ctx.WithBlockHeight(29))
+	assert.Equal(t, []types.EVMRegistration{reg}, k.GetAllPendingEVMRegistrations(ctx))
+	ethAddress, _ := k.GetEthAddress(ctx, otherVal)
+	assert.Equal(t, otherReg.EvmAddress, ethAddress)
+	ethAddress, _ = k.GetEthAddress(ctx, val)
+	assert.NotEqual(t, reg.EvmAddress, ethAddress)
+
+	k.ActivatePendingEVMRegistrations(ctx.WithBlockHeight(30))
+	assert.Empty(t, k.GetAllPendingEVMRegistrations(ctx))
+	_, found = k.GetPendingEVMRegistration(ctx, val)
+	assert.False(t, found)
+	ethAddress, _ = k.GetEthAddress(ctx, val)
+	assert.Equal(t, reg.EvmAddress, ethAddress)
+}
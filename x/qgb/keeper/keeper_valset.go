@@ -2,29 +2,174 @@ package keeper
 
 import (
 	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetValsetConfirm
+// GetValsetConfirm returns the confirm of the valset at the nonce submitted by
+// the orchestrator, or nil if it hasn't confirmed it
 func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm {
-	// TODO
-	return nil
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetConfirmKeyPrefix))
+
+	b := store.Get(types.ValsetConfirmKey(nonce, validator))
+	if b == nil {
+		return nil
+	}
+
+	var confirm types.MsgValsetConfirm
+	k.cdc.MustUnmarshal(b, &confirm)
+	return &confirm
 }
 
-// SetValsetConfirm
+// SetValsetConfirm stores a valset confirm and returns its store key
 func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConfirm) []byte {
-	// TODO
-	return nil
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetConfirmKeyPrefix))
+
+	orchestrator, err := sdk.AccAddressFromBech32(valsetConf.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	key := types.ValsetConfirmKey(valsetConf.Nonce, orchestrator)
+	store.Set(key, k.cdc.MustMarshal(&valsetConf))
+	return key
 }
 
-// GetValsetConfirms
+// GetValsetConfirms returns every confirm of the valset at the nonce
 func (k Keeper) GetValsetConfirms(ctx sdk.Context, nonce uint64) (confirms []types.MsgValsetConfirm) {
-	// TODO
-	return nil
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetConfirmKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ValsetConfirmNoncePrefix(nonce))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var confirm types.MsgValsetConfirm
+		k.cdc.MustUnmarshal(iterator.Value(), &confirm)
+		confirms = append(confirms, confirm)
+	}
+
+	return
 }
 
-// DeleteValsetConfirms
+// DeleteValsetConfirms deletes every confirm of the valset at the nonce
 func (k Keeper) DeleteValsetConfirms(ctx sdk.Context, nonce uint64) {
-	// TODO
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetConfirmKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ValsetConfirmNoncePrefix(nonce))
+
+	// collect the keys first, since the store can't be written to while it
+	// is being iterated over
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetValset stores a valset by its nonce and makes it the latest valset if
+// its nonce is the highest one
+func (k Keeper) SetValset(ctx sdk.Context, valset types.Valset) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetKeyPrefix))
+	store.Set(sdk.Uint64ToBigEndian(valset.Nonce), k.cdc.MustMarshal(&valset))
+
+	if valset.Nonce > k.GetLatestValsetNonce(ctx) {
+		k.setLatestValsetNonce(ctx, valset.Nonce)
+	}
+}
+
+// GetValset returns the valset created with the nonce
+func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) (val types.Valset, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValsetKeyPrefix))
+
+	b := store.Get(sdk.Uint64ToBigEndian(nonce))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLatestValset returns the most recently created valset
+func (k Keeper) GetLatestValset(ctx sdk.Context) (types.Valset, bool) {
+	return k.GetValset(ctx, k.GetLatestValsetNonce(ctx))
+}
+
+// GetCurrentValset returns a valset of the currently bonded validators that
+// have registered an Ethereum address, using the next nonce. It is not
+// stored.
+func (k Keeper) GetCurrentValset(ctx sdk.Context) types.Valset {
+	var members []types.BridgeValidator
+	for _, validator := range k.staking.GetBondedValidatorsByPower(ctx) {
+		val := validator.GetOperator()
+		ethAddress, found := k.GetEthAddress(ctx, val)
+		if !found {
+			continue
+		}
+		power := k.staking.GetLastValidatorPower(ctx, val)
+		if power <= 0 {
+			continue
+		}
+		members = append(members, types.BridgeValidator{
+			Power:      uint64(power),
+			EthAddress: ethAddress,
+		})
+	}
+
+	return types.NewValset(k.GetLatestValsetNonce(ctx)+1, uint64(ctx.BlockHeight()), members)
+}
+
+// SetValsetRequest snapshots the current valset, stores it using the next
+// nonce, and emits an event so that orchestrators sign it
+func (k Keeper) SetValsetRequest(ctx sdk.Context) types.Valset {
+	valset := k.GetCurrentValset(ctx)
+	k.SetValset(ctx, valset)
+
+	ctx.EventManager().EmitEvent(
+		types.NewValsetRequestEvent(valset),
+	)
+
+	return valset
+}
+
+// GetLatestValsetNonce returns the nonce of the latest valset that validators
+// can sign, or 0 if no valset has been created yet
+func (k Keeper) GetLatestValsetNonce(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.LatestValsetNonceKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// setLatestValsetNonce sets the nonce of the latest valset that validators can
+// sign
+func (k Keeper) setLatestValsetNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.LatestValsetNonceKey), sdk.Uint64ToBigEndian(nonce))
+}
+
+// IsKnownValsetNonce returns true if a valset has been created with the nonce
+func (k Keeper) IsKnownValsetNonce(ctx sdk.Context, nonce uint64) bool {
+	_, found := k.GetValset(ctx, nonce)
+	return found
+}
+
+// GetLastUnbondingHeight returns the last height at which a validator began
+// unbonding
+func (k Keeper) GetLastUnbondingHeight(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.LastUnbondingHeightKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetLastUnbondingHeight sets the last height at which a validator began
+// unbonding
+func (k Keeper) SetLastUnbondingHeight(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.LastUnbondingHeightKey), sdk.Uint64ToBigEndian(height))
 }
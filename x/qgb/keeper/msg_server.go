@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -16,14 +18,151 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
-// ValsetConfirm handles MsgValsetConfirm
+// ValsetConfirm handles MsgValsetConfirm by storing the signature of the
+// orchestrator's validator over the valset at the nonce
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
-	// TODO
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.confirmingValidator(ctx, msg.Orchestrator, msg.EthAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valset, found := k.GetValset(ctx, msg.Nonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownValsetNonce, "%d", msg.Nonce)
+	}
+
+	// only the members of the valset can confirm it, so that the signatures
+	// relayed to the QGB contract are from the validators it expects
+	if !valset.HasMember(msg.EthAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotValsetMember, "%s at nonce %d", msg.EthAddress, msg.Nonce)
+	}
+
+	// the signature must be over the checkpoint of the valset that the QGB
+	// contract computes, made by the registered Ethereum key
+	checkpoint, err := valset.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := types.DecodeEthSignature(msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.VerifyEthSignature(checkpoint, signature, msg.EthAddress); err != nil {
+		return nil, err
+	}
+
+	orch, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	if k.GetValsetConfirm(ctx, msg.Nonce, orch) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicateValsetConfirm, "nonce %d", msg.Nonce)
+	}
+
+	k.SetValsetConfirm(ctx, *msg)
+
+	ctx.EventManager().EmitEvent(
+		types.NewValsetConfirmEvent(msg.Nonce, msg.Orchestrator, val, msg.EthAddress),
+	)
+
 	return &types.MsgValsetConfirmResponse{}, nil
 }
 
-// DataCommitmentConfirm handles MsgDataCommitmentConfirm
-func (k msgServer) DataCommitmentConfirm(context.Context, *types.MsgDataCommitmentConfirm) (*types.MsgDataCommitmentConfirmResponse, error) {
-	// TODO
+// DataCommitmentConfirm handles MsgDataCommitmentConfirm by storing the
+// signature of the orchestrator's validator over the data commitment
+func (k msgServer) DataCommitmentConfirm(c context.Context, msg *types.MsgDataCommitmentConfirm) (*types.MsgDataCommitmentConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.confirmingValidator(ctx, msg.Orchestrator, msg.EthAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// only blocks that have already been committed can be attested to
+	if msg.EndBlock >= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(types.ErrFutureDataCommitment, "end block %d at height %d", msg.EndBlock, ctx.BlockHeight())
+	}
+
+	commitment, err := types.DecodeCommitment(msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := types.DecodeEthSignature(msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.DataCommitmentSignBytes(msg.EndBlock, commitment)
+	if err := types.VerifyEthSignature(hash, signature, msg.EthAddress); err != nil {
+		return nil, err
+	}
+	orch, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	if k.GetDataCommitmentConfirm(ctx, msg.EndBlock, commitment, orch) != nil {
+		return nil, sdkerrors.Wrap(types.ErrDuplicateDataCommitmentConfirm, msg.Commitment)
+	}
+
+	k.SetDataCommitmentConfirm(ctx, *msg)
+
+	ctx.EventManager().EmitEvent(
+		types.NewDataCommitmentConfirmEvent(msg, val),
+	)
+
 	return &types.MsgDataCommitmentConfirmResponse{}, nil
 }
+
+// RegisterEVMAddress handles MsgRegisterEVMAddress by binding the validator to
+// the orchestrator and the Ethereum address. The first registration of a
+// validator is active immediately, while later registrations become active
+// after the EVMAddressRotationDelay param, so that confirms that are in flight
+// can still be submitted.
+func (k msgServer) RegisterEVMAddress(c context.Context, msg *types.MsgRegisterEVMAddress) (*types.MsgRegisterEVMAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	orch, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if k.staking.Validator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownValidator, msg.ValidatorAddress)
+	}
+
+	signature, err := types.DecodeEthSignature(msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.RegisterEVMAddressHash(ctx.ChainID(), val, orch)
+	if err := types.VerifyEthSignature(hash, signature, msg.EvmAddress); err != nil {
+		return nil, err
+	}
+
+	reg := types.EVMRegistration{
+		ValidatorAddress:    msg.ValidatorAddress,
+		OrchestratorAddress: msg.OrchestratorAddress,
+		EvmAddress:          msg.EvmAddress,
+		ActivationHeight:    uint64(ctx.BlockHeight()),
+	}
+	if err := k.checkRegistrationConflicts(ctx, reg); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetEVMRegistration(ctx, val); found {
+		reg.ActivationHeight += k.EVMAddressRotationDelay(ctx)
+		k.SetPendingEVMRegistration(ctx, reg)
+	} else {
+		k.SetEVMRegistration(ctx, reg)
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewRegisterEVMAddressEvent(reg),
+	)
+
+	return &types.MsgRegisterEVMAddressResponse{}, nil
+}
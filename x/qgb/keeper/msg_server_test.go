@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/celestiaorg/celestia-app/testutil"
+	"github.com/celestiaorg/celestia-app/x/qgb/keeper"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// register makes the registration of the validator active
+func register(ctx sdk.Context, k keeper.Keeper, val sdk.ValAddress, orch sdk.AccAddress, ethAddress string) {
+	k.SetEVMRegistration(ctx, types.EVMRegistration{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orch.String(),
+		EvmAddress:          ethAddress,
+	})
+}
+
+// newEthKey returns a new Ethereum private key and its address
+func newEthKey(t *testing.T) (*btcec.PrivateKey, string) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	return privKey, types.EthAddressFromPubKey(&privKey.ToECDSA().PublicKey)
+}
+
+// signHash returns the hex encoded Ethereum signature of the hash
+func signHash(t *testing.T, privKey *btcec.PrivateKey, hash []byte) string {
+	signature, err := types.SignEthHash(privKey.ToECDSA(), hash)
+	require.NoError(t, err)
+	return hex.EncodeToString(signature)
+}
+
+// signValset returns the hex encoded Ethereum signature of the valset
+// checkpoint
+func signValset(t *testing.T, privKey *btcec.PrivateKey, valset types.Valset) string {
+	checkpoint, err := valset.SignBytes()
+	require.NoError(t, err)
+	return signHash(t, privKey, checkpoint)
+}
+
+func TestValsetConfirm(t *testing.T) {
+	orch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherOrch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	val := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testApp := testutil.SetupTestApp(t, orch)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := testApp.QgbKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	privKey, ethAddress := newEthKey(t)
+	otherPrivKey, otherEthAddress := newEthKey(t)
+
+	register(ctx, k, val, orch, ethAddress)
+	register(ctx, k, otherVal, otherOrch, otherEthAddress)
+	valset1 := types.NewValset(1, 1, []types.BridgeValidator{{EthAddress: ethAddress, Power: 10}})
+	valset2 := types.NewValset(2, 2, []types.BridgeValidator{{EthAddress: ethAddress, Power: 10}, {EthAddress: otherEthAddress, Power: 5}})
+	k.SetValset(ctx, valset1)
+	k.SetValset(ctx, valset2)
+
+	type test struct {
+		name      string
+		msg       *types.MsgValsetConfirm
+		expectErr error
+	}
+
+	tests := []test{
+		{
+			name: "valid confirm",
+			msg:  types.NewMsgValsetConfirm(1, orch, ethAddress, signValset(t, privKey, valset1)),
+		},
+		{
+			name:      "duplicate confirm",
+			msg:       types.NewMsgValsetConfirm(1, orch, ethAddress, signValset(t, privKey, valset1)),
+			expectErr: types.ErrDuplicateValsetConfirm,
+		},
+		{
+			name:      "signature over another valset",
+			msg:       types.NewMsgValsetConfirm(2, orch, ethAddress, signValset(t, privKey, valset1)),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name:      "signature by another Ethereum key",
+			msg:       types.NewMsgValsetConfirm(2, orch, ethAddress, signValset(t, otherPrivKey, valset2)),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name:      "malformed signature",
+			msg:       types.NewMsgValsetConfirm(2, orch, ethAddress, "signature"),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name: "uppercase Ethereum address",
+			msg:  types.NewMsgValsetConfirm(2, orch, "0x"+strings.ToUpper(ethAddress[2:]), signValset(t, privKey, valset2)),
+		},
+		{
+			name:      "unknown nonce",
+			msg:       types.NewMsgValsetConfirm(3, orch, ethAddress, signValset(t, privKey, types.Valset{Nonce: 3})),
+			expectErr: types.ErrUnknownValsetNonce,
+		},
+		{
+			name:      "unregistered orchestrator",
+			msg:       types.NewMsgValsetConfirm(1, other, ethAddress, signValset(t, privKey, valset1)),
+			expectErr: types.ErrUnknownOrchestrator,
+		},
+		{
+			name:      "different Ethereum address",
+			msg:       types.NewMsgValsetConfirm(2, orch, otherEthAddress, signValset(t, otherPrivKey, valset2)),
+			expectErr: types.ErrEthAddressMismatch,
+		},
+		{
+			name:      "Ethereum address that isn't a member of the valset",
+			msg:       types.NewMsgValsetConfirm(1, otherOrch, otherEthAddress, signValset(t, otherPrivKey, valset1)),
+			expectErr: types.ErrNotValsetMember,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := msgServer.ValsetConfirm(goCtx, tt.msg)
+		if tt.expectErr != nil {
+			assert.ErrorIs(t, err, tt.expectErr, tt.name)
+			continue
+		}
+		assert.NoError(t, err, tt.name)
+	}
+
+	confirm := k.GetValsetConfirm(ctx, 1, orch)
+	require.NotNil(t, confirm)
+	assert.Equal(t, ethAddress, confirm.EthAddress)
+	assert.Nil(t, k.GetValsetConfirm(ctx, 1, other))
+	assert.Len(t, k.GetValsetConfirms(ctx, 1), 1)
+	assert.Len(t, k.GetValsetConfirms(ctx, 2), 1)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, types.EventTypeValsetConfirm, events[0].Type)
+
+	k.DeleteValsetConfirms(ctx, 1)
+	assert.Empty(t, k.GetValsetConfirms(ctx, 1))
+	assert.Len(t, k.GetValsetConfirms(ctx, 2), 1)
+}
+
+func TestDataCommitmentConfirm(t *testing.T) {
+	orch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherOrch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	val := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherVal := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testApp := testutil.SetupTestApp(t, orch)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	goCtx := sdk.WrapSDKContext(ctx)
+	k := testApp.QgbKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	privKey, ethAddress := newEthKey(t)
+	otherPrivKey, otherEthAddress := newEthKey(t)
+
+	register(ctx, k, val, orch, ethAddress)
+	register(ctx, k, otherVal, otherOrch, otherEthAddress)
+
+	commitment := bytes.Repeat([]byte{1}, types.CommitmentLength)
+	hexCommitment := hex.EncodeToString(commitment)
+	signature := signHash(t, privKey, types.DataCommitmentSignBytes(9, commitment))
+	otherSignature := signHash(t, otherPrivKey, types.DataCommitmentSignBytes(9, commitment))
+
+	commitment2 := bytes.Repeat([]byte{2}, types.CommitmentLength)
+	hexCommitment2 := hex.EncodeToString(commitment2)
+
+	type test struct {
+		name      string
+		msg       *types.MsgDataCommitmentConfirm
+		expectErr error
+	}
+
+	tests := []test{
+		{
+			name: "valid confirm",
+			msg:  types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, hexCommitment, 9),
+		},
+		{
+			name:      "duplicate confirm",
+			msg:       types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, hexCommitment, 9),
+			expectErr: types.ErrDuplicateDataCommitmentConfirm,
+		},
+		{
+			name: "confirm from another validator",
+			msg:  types.NewMsgDataCommitmentConfirm(otherSignature, otherOrch, otherEthAddress, hexCommitment, 9),
+		},
+		{
+			name: "confirm of the commitment up to another end block",
+			msg:  types.NewMsgDataCommitmentConfirm(signHash(t, privKey, types.DataCommitmentSignBytes(8, commitment)), orch, ethAddress, hexCommitment, 8),
+		},
+		{
+			name:      "Ethereum address of another validator",
+			msg:       types.NewMsgDataCommitmentConfirm(otherSignature, orch, otherEthAddress, hexCommitment2, 9),
+			expectErr: types.ErrEthAddressMismatch,
+		},
+		{
+			name:      "signature over another commitment",
+			msg:       types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, hexCommitment2, 9),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name:      "signature over another end block",
+			msg:       types.NewMsgDataCommitmentConfirm(signHash(t, privKey, types.DataCommitmentSignBytes(8, commitment2)), orch, ethAddress, hexCommitment2, 9),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name:      "signature by another Ethereum key",
+			msg:       types.NewMsgDataCommitmentConfirm(signHash(t, otherPrivKey, types.DataCommitmentSignBytes(9, commitment2)), orch, ethAddress, hexCommitment2, 9),
+			expectErr: types.ErrInvalidEthSignature,
+		},
+		{
+			name:      "blocks that haven't been committed",
+			msg:       types.NewMsgDataCommitmentConfirm(signHash(t, privKey, types.DataCommitmentSignBytes(10, commitment2)), orch, ethAddress, hexCommitment2, 10),
+			expectErr: types.ErrFutureDataCommitment,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := msgServer.DataCommitmentConfirm(goCtx, tt.msg)
+		if tt.expectErr != nil {
+			assert.ErrorIs(t, err, tt.expectErr, tt.name)
+			continue
+		}
+		assert.NoError(t, err, tt.name)
+	}
+
+	confirm := k.GetDataCommitmentConfirm(ctx, 9, commitment, orch)
+	require.NotNil(t, confirm)
+	assert.Equal(t, uint64(9), confirm.EndBlock)
+	assert.Len(t, k.GetDataCommitmentConfirms(ctx, 9, commitment), 2)
+	assert.Len(t, k.GetDataCommitmentConfirms(ctx, 8, commitment), 1)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	assert.Equal(t, types.EventTypeDataCommitmentConfirm, events[0].Type)
+
+	k.DeleteDataCommitmentConfirms(ctx, 9, commitment)
+	assert.Empty(t, k.GetDataCommitmentConfirms(ctx, 9, commitment))
+	assert.Len(t, k.GetDataCommitmentConfirms(ctx, 8, commitment), 1)
+}
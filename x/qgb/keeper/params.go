@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.ValsetPowerChangeThreshold(ctx),
+		k.EVMAddressRotationDelay(ctx),
+	)
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// ValsetPowerChangeThreshold returns the ValsetPowerChangeThreshold param
+func (k Keeper) ValsetPowerChangeThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValsetPowerChangeThreshold, &res)
+	return
+}
+
+// EVMAddressRotationDelay returns the EVMAddressRotationDelay param
+func (k Keeper) EVMAddressRotationDelay(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyEVMAddressRotationDelay, &res)
+	return
+}
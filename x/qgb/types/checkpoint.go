@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// PowerThreshold is the normalized power that must sign a valset update or a
+// data commitment for the QGB contract to accept it, two thirds of TotalPower
+const PowerThreshold = TotalPower * 2 / 3
+
+var (
+	// ValsetDomainSeparator is the bytes32 encoding of "checkpoint", which the
+	// QGB contract uses to domain separate valset checkpoints
+	ValsetDomainSeparator = domainSeparator("checkpoint")
+	// DataCommitmentDomainSeparator is the bytes32 encoding of
+	// "transactionBatch", which the QGB contract uses to domain separate data
+	// commitments
+	DataCommitmentDomainSeparator = domainSeparator("transactionBatch")
+)
+
+// Hash returns the validator set hash of the valset, computed like the QGB
+// contract as keccak256(abi.encode(Validator[])), where a Validator is an
+// (address addr, uint256 power) tuple
+func (v Valset) Hash() ([]byte, error) {
+	// a dynamic array is encoded as its offset, its length and then the
+	// static tuples of its elements
+	encoded := make([]byte, 0, 64+64*len(v.Members))
+	encoded = append(encoded, abiUint256(32)...)
+	encoded = append(encoded, abiUint256(uint64(len(v.Members)))...)
+	for _, m := range v.Members {
+		address, err := abiAddress(m.EthAddress)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, address...)
+		encoded = append(encoded, abiUint256(m.Power)...)
+	}
+	return Keccak256(encoded), nil
+}
+
+// SignBytes returns the checkpoint of the valset that the members sign, as
+// computed by the domainSeparateValidatorSetHash function of the QGB contract:
+// keccak256(abi.encode(ValsetDomainSeparator, nonce, PowerThreshold, hash))
+func (v Valset) SignBytes() ([]byte, error) {
+	hash, err := v.Hash()
+	if err != nil {
+		return nil, err
+	}
+	return Keccak256(
+		ValsetDomainSeparator,
+		abiUint256(v.Nonce),
+		abiUint256(PowerThreshold),
+		hash,
+	), nil
+}
+
+// DataCommitmentSignBytes returns the digest that the validators sign for the
+// data commitment, as computed by the domainSeparateDataRootTupleRoot function
+// of the QGB contract: keccak256(abi.encode(DataCommitmentDomainSeparator,
+// nonce, commitment)). The nonce of a data commitment is its end block.
+func DataCommitmentSignBytes(nonce uint64, commitment []byte) []byte {
+	return Keccak256(
+		DataCommitmentDomainSeparator,
+		abiUint256(nonce),
+		commitment,
+	)
+}
+
+// domainSeparator returns the bytes32 encoding of the string, right padded
+// with zeros like Solidity string literals
+func domainSeparator(s string) []byte {
+	separator := make([]byte, 32)
+	copy(separator, s)
+	return separator
+}
+
+// abiUint256 returns the ABI encoding of the number as a uint256
+func abiUint256(n uint64) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], n)
+	return word
+}
+
+// abiAddress returns the ABI encoding of the hex encoded Ethereum address,
+// left padded with zeros
+func abiAddress(address string) ([]byte, error) {
+	if err := ValidateEthAddress(address); err != nil {
+		return nil, err
+	}
+	// the address was validated to be hex encoded
+	bz, _ := hex.DecodeString(address[2:])
+	word := make([]byte, 32)
+	copy(word[32-EthAddressLength:], bz)
+	return word, nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainSeparators(t *testing.T) {
+	// the VALIDATOR_SET_HASH_DOMAIN_SEPARATOR and
+	// DATA_ROOT_TUPLE_ROOT_DOMAIN_SEPARATOR constants of the QGB contract
+	assert.Equal(t,
+		"636865636b706f696e7400000000000000000000000000000000000000000000",
+		hex.EncodeToString(types.ValsetDomainSeparator),
+	)
+	assert.Equal(t,
+		"7472616e73616374696f6e426174636800000000000000000000000000000000",
+		hex.EncodeToString(types.DataCommitmentDomainSeparator),
+	)
+	assert.Equal(t, uint64(2863311530), types.PowerThreshold)
+}
+
+func TestValsetSignBytes(t *testing.T) {
+	valset := types.Valset{
+		Nonce: 1,
+		Members: []types.BridgeValidator{
+			{EthAddress: "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", Power: 3000000000},
+			{EthAddress: "0x91DEd26b5f38B065FC0204c7929Da1b2A21877Ad", Power: 1294967295},
+		},
+	}
+
+	// keccak256(abi.encode(Validator[])) and the output of
+	// domainSeparateValidatorSetHash for the same valset
+	hash, err := valset.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, "4f7f092d30f0101639d43bf88d53dbfd737c3eb95bbd1455ec6609bfa2063221", hex.EncodeToString(hash))
+
+	checkpoint, err := valset.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "5d04bbebc2727a5cf934418ed77ba58e13755643175bbc51bfcd223b9b887d5d", hex.EncodeToString(checkpoint))
+
+	// the checkpoint of an empty valset at nonce 0
+	checkpoint, err = types.Valset{}.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "7953bd94fce94e0972c0c606ec9bd4931faf0f4893e22fd53bfc5a598ec20a63", hex.EncodeToString(checkpoint))
+
+	// the address case doesn't change the checkpoint
+	valset.Members[0].EthAddress = "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+	lowercase, err := valset.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "5d04bbebc2727a5cf934418ed77ba58e13755643175bbc51bfcd223b9b887d5d", hex.EncodeToString(lowercase))
+
+	valset.Members[0].EthAddress = "0x01"
+	_, err = valset.SignBytes()
+	assert.ErrorIs(t, err, types.ErrInvalidEthAddress)
+}
+
+func TestDataCommitmentSignBytes(t *testing.T) {
+	// the output of domainSeparateDataRootTupleRoot for the nonce and data
+	// root tuple root
+	hash := types.DataCommitmentSignBytes(10, bytes.Repeat([]byte{1}, types.CommitmentLength))
+	assert.Equal(t, "264636a6a55aafaa50c6bd1caf65529b6742339aacfa34eea277acd2f1efd73d", hex.EncodeToString(hash))
+}
+
+func TestSignValsetCheckpoint(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	address := types.EthAddressFromPubKey(&privKey.ToECDSA().PublicKey)
+
+	valset := types.NewValset(2, 10, []types.BridgeValidator{{EthAddress: address, Power: 10}})
+	checkpoint, err := valset.SignBytes()
+	require.NoError(t, err)
+
+	signature, err := types.SignEthHash(privKey.ToECDSA(), checkpoint)
+	require.NoError(t, err)
+	assert.NoError(t, types.VerifyEthSignature(checkpoint, signature, address))
+
+	// a signature over the checkpoint of another nonce isn't valid
+	valset.Nonce = 3
+	other, err := valset.SignBytes()
+	require.NoError(t, err)
+	assert.ErrorIs(t, types.VerifyEthSignature(other, signature, address), types.ErrInvalidEthSignature)
+}
@@ -11,6 +11,7 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDataCommitmentConfirm{}, "qgb/DataCommitmentConfirm", nil)
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "qgb/ValSetConfirm", nil)
+	cdc.RegisterConcrete(&MsgRegisterEVMAddress{}, "qgb/RegisterEVMAddress", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -23,5 +24,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgValsetConfirm{},
 	)
 
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterEVMAddress{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,15 +1,74 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CommitmentLength is the length of a data commitment in bytes
+const CommitmentLength = 32
+
+var _ sdk.Msg = &MsgDataCommitmentConfirm{}
+
+// NewMsgDataCommitmentConfirm creates a new MsgDataCommitmentConfirm
+func NewMsgDataCommitmentConfirm(
+	signature string,
+	orchestrator sdk.AccAddress,
+	ethAddress string,
+	commitment string,
+	endBlock uint64,
+) *MsgDataCommitmentConfirm {
+	return &MsgDataCommitmentConfirm{
+		Signature:    signature,
+		Orchestrator: orchestrator.String(),
+		EthAddress:   ethAddress,
+		Commitment:   commitment,
+		EndBlock:     endBlock,
+	}
+}
 
 // GetSigners defines whose signature is required
 func (msg *MsgDataCommitmentConfirm) GetSigners() []sdk.AccAddress {
-	// TODO
-	return []sdk.AccAddress{}
+	// the orchestrator signs on behalf of the validator it is registered for
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
 }
 
-// ValidateBasic
+// ValidateBasic performs stateless validity checks on the msg
 func (msg *MsgDataCommitmentConfirm) ValidateBasic() error {
-	// TODO
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return fmt.Errorf("invalid orchestrator address: %w", err)
+	}
+	if err := ValidateEthAddress(msg.EthAddress); err != nil {
+		return err
+	}
+	if _, err := DecodeCommitment(msg.Commitment); err != nil {
+		return err
+	}
+	if msg.EndBlock == 0 {
+		return errors.New("the end block must be greater than 0")
+	}
+	if _, err := DecodeEthSignature(msg.Signature); err != nil {
+		return err
+	}
 	return nil
 }
+
+// DecodeCommitment decodes a hex encoded data commitment
+func DecodeCommitment(commitment string) ([]byte, error) {
+	bz, err := hex.DecodeString(commitment)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidCommitment, "failure to decode hex commitment: %s", err)
+	}
+	if len(bz) != CommitmentLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidCommitment, "got %d bytes wanted %d", len(bz), CommitmentLength)
+	}
+	return bz, nil
+}
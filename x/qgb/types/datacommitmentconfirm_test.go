@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgDataCommitmentConfirmValidateBasic(t *testing.T) {
+	orch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	commitment := hex.EncodeToString(bytes.Repeat([]byte{1}, types.CommitmentLength))
+
+	type test struct {
+		name      string
+		msg       *types.MsgDataCommitmentConfirm
+		expectErr bool
+	}
+
+	tests := []test{
+		{
+			name: "valid msg",
+			msg:  types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, commitment, 10),
+		},
+		{
+			name:      "short commitment",
+			msg:       types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, commitment[2:], 10),
+			expectErr: true,
+		},
+		{
+			name:      "zero end block",
+			msg:       types.NewMsgDataCommitmentConfirm(signature, orch, ethAddress, commitment, 0),
+			expectErr: true,
+		},
+		{
+			name:      "invalid Ethereum address",
+			msg:       types.NewMsgDataCommitmentConfirm(signature, orch, "not an address", commitment, 10),
+			expectErr: true,
+		},
+		{
+			name:      "missing signature",
+			msg:       types.NewMsgDataCommitmentConfirm("", orch, ethAddress, commitment, 10),
+			expectErr: true,
+		},
+		{
+			name:      "short signature",
+			msg:       types.NewMsgDataCommitmentConfirm(signature[:130], orch, ethAddress, commitment, 10),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.expectErr {
+			assert.Error(t, err, tt.name)
+			continue
+		}
+		assert.NoError(t, err, tt.name)
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/qgb module sentinel errors
+var (
+	ErrUnknownValsetNonce             = sdkerrors.Register(ModuleName, 1100, "no valset has been requested for the nonce")
+	ErrDuplicateValsetConfirm         = sdkerrors.Register(ModuleName, 1101, "the orchestrator has already confirmed the valset")
+	ErrUnknownOrchestrator            = sdkerrors.Register(ModuleName, 1102, "the orchestrator is not registered for any validator")
+	ErrEthAddressMismatch             = sdkerrors.Register(ModuleName, 1103, "the Ethereum address does not match the validator's registered address")
+	ErrInvalidEthAddress              = sdkerrors.Register(ModuleName, 1104, "invalid Ethereum address")
+	ErrInvalidCommitment              = sdkerrors.Register(ModuleName, 1105, "invalid data commitment")
+	ErrDuplicateDataCommitmentConfirm = sdkerrors.Register(ModuleName, 1106, "the orchestrator has already confirmed the data commitment")
+	ErrFutureDataCommitment           = sdkerrors.Register(ModuleName, 1107, "the data commitment includes blocks that haven't been committed yet")
+	ErrInvalidEthSignature            = sdkerrors.Register(ModuleName, 1108, "invalid Ethereum signature")
+	ErrUnknownValidator               = sdkerrors.Register(ModuleName, 1109, "the validator does not exist")
+	ErrOrchestratorAlreadyRegistered  = sdkerrors.Register(ModuleName, 1110, "the orchestrator is registered for another validator")
+	ErrEthAddressAlreadyRegistered    = sdkerrors.Register(ModuleName, 1111, "the Ethereum address is registered for another validator")
+	ErrNotValsetMember                = sdkerrors.Register(ModuleName, 1112, "the Ethereum address is not a member of the valset")
+)
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// EthAddressLength is the length of an Ethereum address in bytes
+	EthAddressLength = 20
+	// EthSignatureLength is the length of an Ethereum signature in bytes,
+	// made of R, S and the recovery ID V
+	EthSignatureLength = 65
+
+	// ethSignedMessagePrefix is the EIP-191 prefix of the hash signed by
+	// Ethereum wallets and checked by the QGB contract
+	ethSignedMessagePrefix = "\x19Ethereum Signed Message:\n32"
+)
+
+// ValidateEthAddress checks that the address is a 0x prefixed, hex encoded
+// Ethereum address
+func ValidateEthAddress(address string) error {
+	if !strings.HasPrefix(address, "0x") {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s is not 0x prefixed", address)
+	}
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s is not hex encoded", address)
+	}
+	if len(bz) != EthAddressLength {
+		return sdkerrors.Wrapf(ErrInvalidEthAddress, "%s is %d bytes long instead of %d", address, len(bz), EthAddressLength)
+	}
+	return nil
+}
+
+// Keccak256 returns the Keccak-256 hash of the data, as used by Ethereum
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		hasher.Write(b)
+	}
+	return hasher.Sum(nil)
+}
+
+// EthSignedMessageHash returns the EIP-191 hash that an Ethereum key signs for
+// the hash
+func EthSignedMessageHash(hash []byte) []byte {
+	return Keccak256([]byte(ethSignedMessagePrefix), hash)
+}
+
+// EthAddressFromPubKey returns the lowercase hex encoded Ethereum address of
+// the public key
+func EthAddressFromPubKey(pubKey *ecdsa.PublicKey) string {
+	uncompressed := (*btcec.PublicKey)(pubKey).SerializeUncompressed()
+	return "0x" + hex.EncodeToString(Keccak256(uncompressed[1:])[12:])
+}
+
+// SignEthHash signs the EIP-191 hash of the hash using the private key, and
+// returns the signature in the [R || S || V] format used by Ethereum, where V
+// is 27 or 28
+func SignEthHash(privKey *ecdsa.PrivateKey, hash []byte) ([]byte, error) {
+	// the compact signature is [V || R || S], where V is 27 or 28 for
+	// uncompressed keys
+	compact, err := btcec.SignCompact(btcec.S256(), (*btcec.PrivateKey)(privKey), EthSignedMessageHash(hash), false)
+	if err != nil {
+		return nil, err
+	}
+	return append(compact[1:], compact[0]), nil
+}
+
+// VerifyEthSignature checks that the signature over the EIP-191 hash of the
+// hash was made by the key of the Ethereum address. Both the 0/1 and 27/28
+// forms of the recovery ID are accepted.
+func VerifyEthSignature(hash []byte, signature []byte, ethAddress string) error {
+	if len(signature) != EthSignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidEthSignature, "got %d bytes wanted %d", len(signature), EthSignatureLength)
+	}
+
+	v := signature[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return sdkerrors.Wrapf(ErrInvalidEthSignature, "invalid recovery id %d", signature[64])
+	}
+
+	compact := make([]byte, 0, EthSignatureLength)
+	compact = append(compact, v)
+	compact = append(compact, signature[:64]...)
+
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compact, EthSignedMessageHash(hash))
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidEthSignature, err.Error())
+	}
+
+	signer := EthAddressFromPubKey(pubKey.ToECDSA())
+	if !strings.EqualFold(signer, ethAddress) {
+		return sdkerrors.Wrapf(ErrInvalidEthSignature, "signed by %s instead of %s", signer, ethAddress)
+	}
+	return nil
+}
+
+// DecodeEthSignature decodes a hex encoded Ethereum signature, which can be
+// 0x prefixed
+func DecodeEthSignature(signature string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidEthSignature, "failure to decode hex signature: %s", err)
+	}
+	if len(bz) != EthSignatureLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidEthSignature, "got %d bytes wanted %d", len(bz), EthSignatureLength)
+	}
+	return bz, nil
+}
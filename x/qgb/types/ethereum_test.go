@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeccak256(t *testing.T) {
+	assert.Equal(t,
+		"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		hex.EncodeToString(types.Keccak256()),
+	)
+}
+
+func TestEthSignatures(t *testing.T) {
+	keyBz, err := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), keyBz)
+
+	// the address of the private key, as derived by Ethereum clients
+	address := types.EthAddressFromPubKey(&privKey.ToECDSA().PublicKey)
+	assert.Equal(t, "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23", address)
+
+	hash := types.Keccak256([]byte("hello"))
+	signature, err := types.SignEthHash(privKey.ToECDSA(), hash)
+	require.NoError(t, err)
+	require.Len(t, signature, types.EthSignatureLength)
+
+	// the checksummed address is accepted
+	assert.NoError(t, types.VerifyEthSignature(hash, signature, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"))
+
+	// the recovery ID can also be 0 or 1
+	raw := append([]byte{}, signature...)
+	raw[64] -= 27
+	assert.NoError(t, types.VerifyEthSignature(hash, raw, address))
+
+	assert.ErrorIs(t, types.VerifyEthSignature(types.Keccak256([]byte("other")), signature, address), types.ErrInvalidEthSignature)
+	assert.ErrorIs(t, types.VerifyEthSignature(hash, signature, ethAddress), types.ErrInvalidEthSignature)
+	assert.ErrorIs(t, types.VerifyEthSignature(hash, signature[:64], address), types.ErrInvalidEthSignature)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypeValsetRequest         = "valset_request"
+	EventTypeValsetConfirm         = "valset_confirm"
+	EventTypeDataCommitmentConfirm = "data_commitment_confirm"
+	EventTypeRegisterEVMAddress    = "register_evm_address"
+	EventTypeActivateRegistration  = "activate_evm_registration"
+
+	AttributeKeyNonce        = "nonce"
+	AttributeKeyHeight       = "height"
+	AttributeKeyMembers      = "members"
+	AttributeKeyOrchestrator = "orchestrator"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyEthAddress   = "eth_address"
+	AttributeKeyCommitment   = "commitment"
+	AttributeKeyEndBlock     = "end_block"
+	AttributeKeyActivation   = "activation_height"
+)
+
+// NewValsetRequestEvent constructs a new valset_request sdk.Event
+func NewValsetRequestEvent(valset Valset) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeValsetRequest,
+		sdk.NewAttribute(AttributeKeyNonce, strconv.FormatUint(valset.Nonce, 10)),
+		sdk.NewAttribute(AttributeKeyHeight, strconv.FormatUint(valset.Height, 10)),
+		sdk.NewAttribute(AttributeKeyMembers, strconv.Itoa(len(valset.Members))),
+	)
+}
+
+// NewValsetConfirmEvent constructs a new valset_confirm sdk.Event
+func NewValsetConfirmEvent(nonce uint64, orchestrator string, validator sdk.ValAddress, ethAddress string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeValsetConfirm,
+		sdk.NewAttribute(AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+		sdk.NewAttribute(AttributeKeyOrchestrator, orchestrator),
+		sdk.NewAttribute(AttributeKeyValidator, validator.String()),
+		sdk.NewAttribute(AttributeKeyEthAddress, ethAddress),
+	)
+}
+
+// NewDataCommitmentConfirmEvent constructs a new data_commitment_confirm
+// sdk.Event
+func NewDataCommitmentConfirmEvent(msg *MsgDataCommitmentConfirm, validator sdk.ValAddress) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeDataCommitmentConfirm,
+		sdk.NewAttribute(AttributeKeyCommitment, msg.Commitment),
+		sdk.NewAttribute(AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(AttributeKeyOrchestrator, msg.Orchestrator),
+		sdk.NewAttribute(AttributeKeyValidator, validator.String()),
+		sdk.NewAttribute(AttributeKeyEthAddress, msg.EthAddress),
+	)
+}
+
+// NewRegisterEVMAddressEvent constructs a new register_evm_address sdk.Event
+func NewRegisterEVMAddressEvent(reg EVMRegistration) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRegisterEVMAddress,
+		sdk.NewAttribute(AttributeKeyValidator, reg.ValidatorAddress),
+		sdk.NewAttribute(AttributeKeyOrchestrator, reg.OrchestratorAddress),
+		sdk.NewAttribute(AttributeKeyEthAddress, reg.EvmAddress),
+		sdk.NewAttribute(AttributeKeyActivation, strconv.FormatUint(reg.ActivationHeight, 10)),
+	)
+}
+
+// NewActivateEVMRegistrationEvent constructs a new activate_evm_registration
+// sdk.Event
+func NewActivateEVMRegistrationEvent(reg EVMRegistration) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeActivateRegistration,
+		sdk.NewAttribute(AttributeKeyValidator, reg.ValidatorAddress),
+		sdk.NewAttribute(AttributeKeyOrchestrator, reg.OrchestratorAddress),
+		sdk.NewAttribute(AttributeKeyEthAddress, reg.EvmAddress),
+	)
+}
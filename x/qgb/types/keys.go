@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "gqb"
@@ -16,3 +20,97 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_payment"
 )
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	// ValsetConfirmKeyPrefix is the prefix used to store valset confirms,
+	// which are keyed by nonce and then by orchestrator
+	ValsetConfirmKeyPrefix = "ValsetConfirm/value/"
+
+	// DataCommitmentConfirmKeyPrefix is the prefix used to store data
+	// commitment confirms, which are keyed by end block, then by commitment
+	// and then by orchestrator
+	DataCommitmentConfirmKeyPrefix = "DataCommitmentConfirm/value/"
+
+	// ValsetKeyPrefix is the prefix used to store valsets, which are keyed by
+	// nonce
+	ValsetKeyPrefix = "Valset/value/"
+
+	// LatestValsetNonceKey is the key of the nonce of the latest valset that
+	// validators can sign
+	LatestValsetNonceKey = "LatestValsetNonce/value/"
+
+	// LastUnbondingHeightKey is the key of the last height at which a
+	// validator began unbonding
+	LastUnbondingHeightKey = "LastUnbondingHeight/value/"
+
+	// EVMRegistrationKeyPrefix is the prefix used to store the active
+	// registration of a validator, keyed by the validator address
+	EVMRegistrationKeyPrefix = "EVMRegistration/value/"
+
+	// PendingEVMRegistrationKeyPrefix is the prefix used to store the
+	// registration that replaces the active registration of a validator, keyed
+	// by activation height and then by the validator address
+	PendingEVMRegistrationKeyPrefix = "PendingEVMRegistration/value/"
+
+	// PendingEVMRegistrationHeightKeyPrefix is the prefix used to store the
+	// activation height of the pending registration of a validator, keyed by
+	// the validator address
+	PendingEVMRegistrationHeightKeyPrefix = "PendingEVMRegistrationHeight/value/"
+
+	// OrchestratorKeyPrefix is the prefix used to store the validator that an
+	// orchestrator account signs for, keyed by the orchestrator address
+	OrchestratorKeyPrefix = "Orchestrator/value/"
+
+	// EthAddressKeyPrefix is the prefix used to store the validator that an
+	// Ethereum address signs for, keyed by the lowercase Ethereum address
+	EthAddressKeyPrefix = "EthAddress/value/"
+)
+
+// ValsetConfirmKey returns the store key of a valset confirm, relative to
+// ValsetConfirmKeyPrefix. Confirms are ordered by nonce, so the confirms for a
+// nonce can be iterated over using ValsetConfirmNoncePrefix.
+func ValsetConfirmKey(nonce uint64, orchestrator sdk.AccAddress) []byte {
+	return append(ValsetConfirmNoncePrefix(nonce), orchestrator.Bytes()...)
+}
+
+// ValsetConfirmNoncePrefix returns the prefix of the store keys of the valset
+// confirms for a nonce, relative to ValsetConfirmKeyPrefix
+func ValsetConfirmNoncePrefix(nonce uint64) []byte {
+	return sdk.Uint64ToBigEndian(nonce)
+}
+
+// DataCommitmentConfirmKey returns the store key of a data commitment confirm,
+// relative to DataCommitmentConfirmKeyPrefix. The confirms of a commitment up
+// to the end block can be iterated over using
+// DataCommitmentConfirmCommitmentPrefix.
+func DataCommitmentConfirmKey(endBlock uint64, commitment []byte, orchestrator sdk.AccAddress) []byte {
+	return append(DataCommitmentConfirmCommitmentPrefix(endBlock, commitment), orchestrator.Bytes()...)
+}
+
+// DataCommitmentConfirmCommitmentPrefix returns the prefix of the store keys of
+// the confirms of a commitment up to the end block, relative to
+// DataCommitmentConfirmKeyPrefix
+func DataCommitmentConfirmCommitmentPrefix(endBlock uint64, commitment []byte) []byte {
+	prefix := make([]byte, 0, 8+len(commitment))
+	prefix = append(prefix, sdk.Uint64ToBigEndian(endBlock)...)
+	return append(prefix, commitment...)
+}
+
+// PendingEVMRegistrationKey returns the store key of a pending registration,
+// relative to PendingEVMRegistrationKeyPrefix. Pending registrations are
+// ordered by activation height, so the registrations that reached a height can
+// be iterated over up to PendingEVMRegistrationHeightPrefix(height + 1).
+func PendingEVMRegistrationKey(activationHeight uint64, val sdk.ValAddress) []byte {
+	return append(PendingEVMRegistrationHeightPrefix(activationHeight), val.Bytes()...)
+}
+
+// PendingEVMRegistrationHeightPrefix returns the prefix of the store keys of
+// the registrations pending until the activation height, relative to
+// PendingEVMRegistrationKeyPrefix
+func PendingEVMRegistrationHeightPrefix(activationHeight uint64) []byte {
+	return sdk.Uint64ToBigEndian(activationHeight)
+}
@@ -32,10 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // MsgValsetConfirm
 // this is the message sent by the validators when they wish to submit their
 // signatures over the validator set at a given block height. A validator must
-// first call MsgSetEthAddress to set their Ethereum address to be used for
-// signing. Then someone (anyone) must make a ValsetRequest, the request is
-// essentially a messaging mechanism to determine which block all validators
-// should submit signatures over. Finally validators sign the validator set,
+// first call MsgRegisterEVMAddress to set their Ethereum address to be used for
+// signing. Then a valset request is made by the EndBlocker when the validator
+// set changes, the request is essentially a messaging mechanism to determine
+// which block all validators should submit signatures over. Finally validators sign the validator set,
 // powers, and Ethereum addresses of the entire validator set at the height of a
 // ValsetRequest and submit that signature with this message.
 //
@@ -48,7 +48,9 @@ type MsgValsetConfirm struct {
 	Nonce        uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
-	Signature    string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Hex encoded EIP-191 signature over the valset checkpoint computed by the
+	// QGB contract, made using the Ethereum key
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
@@ -151,7 +153,26 @@ func (m *MsgValsetConfirmResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgValsetConfirmResponse proto.InternalMessageInfo
 
 // MsgDataCommitmentConfirm describes a data commitment for a set of blocks.
+// Validators sign the data root tuple root of the blocks up to end_block with
+// their Ethereum key, so that the commitment can be relayed to the QGB
+// contract once enough voting power has confirmed it. The QGB contract only
+// identifies a commitment by its end block, so the first block of the range is
+// not part of the msg.
 type MsgDataCommitmentConfirm struct {
+	// Hex encoded EIP-191 signature over the commitment, domain separated like
+	// the QGB contract with the end block as the nonce, made using the Ethereum
+	// key
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Account address of the orchestrator submitting the confirm on behalf of
+	// its validator
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	// Hex encoded Ethereum address of the key that made the signature
+	EthAddress string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	// Hex encoded merkle root of the data root tuples of the blocks
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// Last block of the range, inclusive, which is the nonce of the commitment
+	// in the QGB contract
+	EndBlock uint64 `protobuf:"varint,5,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
 }
 
 func (m *MsgDataCommitmentConfirm) Reset()         { *m = MsgDataCommitmentConfirm{} }
@@ -187,6 +208,41 @@ func (m *MsgDataCommitmentConfirm) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDataCommitmentConfirm proto.InternalMessageInfo
 
+func (m *MsgDataCommitmentConfirm) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *MsgDataCommitmentConfirm) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgDataCommitmentConfirm) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+func (m *MsgDataCommitmentConfirm) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *MsgDataCommitmentConfirm) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
 // MsgValsetConfirmResponse describes the response returned after the submission
 // of a MsgDataCommitmentConfirm.
 type MsgDataCommitmentConfirmResponse struct {
@@ -225,41 +281,168 @@ func (m *MsgDataCommitmentConfirmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDataCommitmentConfirmResponse proto.InternalMessageInfo
 
+// MsgRegisterEVMAddress binds a validator to the orchestrator account that
+// submits QGB confirms on its behalf, and to the Ethereum address that signs
+// them. The signature proves that the registering validator controls the
+// Ethereum key. The first registration of a validator is active immediately,
+// while later registrations rotate the binding after the
+// EVMAddressRotationDelay param.
+type MsgRegisterEVMAddress struct {
+	// Operator address of the validator, which signs the msg
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Account address of the orchestrator
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	// Hex encoded Ethereum address
+	EvmAddress string `protobuf:"bytes,3,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// Hex encoded EIP-191 signature over the registration, made using the
+	// Ethereum key
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRegisterEVMAddress) Reset()         { *m = MsgRegisterEVMAddress{} }
+func (m *MsgRegisterEVMAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterEVMAddress) ProtoMessage()    {}
+func (*MsgRegisterEVMAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c696c358dc748aba, []int{4}
+}
+func (m *MsgRegisterEVMAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterEVMAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterEVMAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterEVMAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterEVMAddress.Merge(m, src)
+}
+func (m *MsgRegisterEVMAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterEVMAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterEVMAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterEVMAddress proto.InternalMessageInfo
+
+func (m *MsgRegisterEVMAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterEVMAddress) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterEVMAddress) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterEVMAddress) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// MsgRegisterEVMAddressResponse describes the response returned after the
+// submission of a MsgRegisterEVMAddress.
+type MsgRegisterEVMAddressResponse struct {
+}
+
+func (m *MsgRegisterEVMAddressResponse) Reset()         { *m = MsgRegisterEVMAddressResponse{} }
+func (m *MsgRegisterEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterEVMAddressResponse) ProtoMessage()    {}
+func (*MsgRegisterEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c696c358dc748aba, []int{5}
+}
+func (m *MsgRegisterEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterEVMAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterEVMAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterEVMAddressResponse.Merge(m, src)
+}
+func (m *MsgRegisterEVMAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterEVMAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterEVMAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterEVMAddressResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgValsetConfirm)(nil), "qgb.MsgValsetConfirm")
 	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "qgb.MsgValsetConfirmResponse")
 	proto.RegisterType((*MsgDataCommitmentConfirm)(nil), "qgb.MsgDataCommitmentConfirm")
 	proto.RegisterType((*MsgDataCommitmentConfirmResponse)(nil), "qgb.MsgDataCommitmentConfirmResponse")
+	proto.RegisterType((*MsgRegisterEVMAddress)(nil), "qgb.MsgRegisterEVMAddress")
+	proto.RegisterType((*MsgRegisterEVMAddressResponse)(nil), "qgb.MsgRegisterEVMAddressResponse")
 }
 
 func init() { proto.RegisterFile("qgb/msgs.proto", fileDescriptor_c696c358dc748aba) }
 
 var fileDescriptor_c696c358dc748aba = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3d, 0x4f, 0xe3, 0x40,
-	0x14, 0xcc, 0x26, 0xb9, 0x93, 0xb2, 0xf7, 0xa1, 0x93, 0x2f, 0x91, 0x2c, 0x5f, 0xce, 0x17, 0x59,
-	0x77, 0x52, 0x9a, 0xf3, 0x4a, 0xf0, 0x0b, 0x20, 0x34, 0x14, 0x69, 0x52, 0x50, 0xd0, 0x44, 0x6b,
-	0x67, 0x59, 0x5b, 0x8a, 0xf7, 0x39, 0xbb, 0x2f, 0x08, 0x5a, 0x28, 0x69, 0x90, 0xf8, 0x53, 0x94,
-	0x91, 0x68, 0x28, 0x51, 0x42, 0xcf, 0x5f, 0x40, 0xd9, 0xc4, 0x46, 0x89, 0x02, 0xdd, 0x7b, 0x33,
-	0xf3, 0x66, 0xc6, 0xf2, 0xd2, 0xef, 0x13, 0x19, 0xb1, 0xcc, 0x48, 0x13, 0xe6, 0x1a, 0x10, 0x9c,
-	0xda, 0x44, 0x46, 0x5e, 0x53, 0x82, 0x04, 0xbb, 0xb3, 0xe5, 0xb4, 0xa2, 0xbc, 0xb6, 0x04, 0x90,
-	0x63, 0xc1, 0x78, 0x9e, 0x32, 0xae, 0x14, 0x20, 0xc7, 0x14, 0xd4, 0xfa, 0x30, 0xb8, 0x21, 0xf4,
-	0x47, 0xdf, 0xc8, 0x13, 0x3e, 0x36, 0x02, 0x7b, 0xa0, 0xce, 0x52, 0x9d, 0x39, 0x4d, 0xfa, 0x49,
-	0x81, 0x8a, 0x85, 0x4b, 0x3a, 0xa4, 0x5b, 0x1f, 0xac, 0x16, 0x27, 0xa0, 0x5f, 0x41, 0xc7, 0x89,
-	0x30, 0xa8, 0x39, 0x82, 0x76, 0xab, 0x1d, 0xd2, 0x6d, 0x0c, 0x36, 0x30, 0xe7, 0x0f, 0xfd, 0x22,
-	0x30, 0x19, 0xf2, 0xd1, 0x48, 0x0b, 0x63, 0xdc, 0x9a, 0x95, 0x50, 0x81, 0xc9, 0xc1, 0x0a, 0x71,
-	0xda, 0xb4, 0x61, 0x52, 0xa9, 0x38, 0x4e, 0xb5, 0x70, 0xeb, 0x96, 0x7e, 0x03, 0x02, 0x8f, 0xba,
-	0xdb, 0x65, 0x06, 0xc2, 0xe4, 0xa0, 0x4c, 0xc1, 0x1d, 0x71, 0xe4, 0x3d, 0xc8, 0xb2, 0x14, 0x33,
-	0xa1, 0x0a, 0x4d, 0x10, 0xd0, 0xce, 0x7b, 0x5c, 0x71, 0xbf, 0xf7, 0x42, 0x68, 0xad, 0x6f, 0xa4,
-	0x13, 0xd1, 0x6f, 0x9b, 0x5f, 0xdb, 0x0a, 0x27, 0x32, 0x0a, 0xb7, 0x73, 0xbd, 0xdf, 0x3b, 0xe1,
-	0xb2, 0xce, 0xaf, 0xab, 0x87, 0xe7, 0xbb, 0x6a, 0x2b, 0xf8, 0xc9, 0x96, 0xbf, 0xe2, 0xdc, 0x6a,
-	0x86, 0xf1, 0xda, 0xf2, 0x9a, 0xd0, 0xd6, 0xce, 0x36, 0x4e, 0xe9, 0xba, 0x93, 0xf6, 0xfe, 0x7d,
-	0x48, 0x97, 0xe1, 0x7f, 0x6d, 0xb8, 0x1f, 0xb4, 0x6d, 0xf8, 0x88, 0x23, 0x1f, 0xc6, 0xa5, 0xb8,
-	0x68, 0x71, 0x78, 0x7c, 0x3f, 0xf7, 0xc9, 0x6c, 0xee, 0x93, 0xa7, 0xb9, 0x4f, 0x6e, 0x17, 0x7e,
-	0x65, 0xb6, 0xf0, 0x2b, 0x8f, 0x0b, 0xbf, 0x72, 0xca, 0x64, 0x8a, 0xc9, 0x34, 0x0a, 0x63, 0xc8,
-	0x58, 0x2c, 0xc6, 0xc2, 0x60, 0xca, 0x41, 0xcb, 0x72, 0xfe, 0xcf, 0xf3, 0x9c, 0x5d, 0x58, 0x73,
-	0xbc, 0xcc, 0x85, 0x89, 0x3e, 0xdb, 0xd7, 0xb2, 0xff, 0x1a, 0x00, 0x00, 0xff, 0xff, 0x07, 0xdd,
-	0x60, 0xa7, 0x78, 0x02, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x8e, 0xd3, 0x4e,
+	0x10, 0xce, 0x26, 0x77, 0x3f, 0xfd, 0x32, 0xfc, 0xd1, 0xe1, 0x4b, 0x24, 0xe3, 0xe4, 0x7c, 0x61,
+	0x05, 0xd2, 0x49, 0x88, 0x58, 0xc0, 0x13, 0x70, 0x07, 0x05, 0x45, 0x9a, 0x14, 0x57, 0xd0, 0x44,
+	0x6b, 0x7b, 0xd9, 0x58, 0xd8, 0xbb, 0xce, 0xee, 0x5e, 0x80, 0x16, 0x4a, 0x1a, 0x24, 0xde, 0x85,
+	0x82, 0x27, 0xa0, 0x3c, 0x89, 0x86, 0x12, 0x25, 0x14, 0x3c, 0x06, 0xf2, 0x3a, 0xde, 0x4b, 0x72,
+	0x3e, 0x44, 0x41, 0xb7, 0x33, 0xdf, 0xec, 0x7c, 0xdf, 0xb7, 0x33, 0x36, 0xdc, 0x9c, 0xb1, 0x30,
+	0xc8, 0x14, 0x53, 0xc3, 0x5c, 0x0a, 0x2d, 0x9c, 0xd6, 0x8c, 0x85, 0x5e, 0x87, 0x09, 0x26, 0x4c,
+	0x1c, 0x14, 0xa7, 0x12, 0xf2, 0xfa, 0x4c, 0x08, 0x96, 0xd2, 0x80, 0xe4, 0x49, 0x40, 0x38, 0x17,
+	0x9a, 0xe8, 0x44, 0xf0, 0xd5, 0x45, 0xfc, 0x01, 0xc1, 0xde, 0x48, 0xb1, 0x53, 0x92, 0x2a, 0xaa,
+	0x4f, 0x04, 0x7f, 0x99, 0xc8, 0xcc, 0xe9, 0xc0, 0x2e, 0x17, 0x3c, 0xa2, 0x2e, 0x1a, 0xa0, 0xa3,
+	0x9d, 0x71, 0x19, 0x38, 0x18, 0xae, 0x0b, 0x19, 0x4d, 0xa9, 0xd2, 0x92, 0x68, 0x21, 0xdd, 0xe6,
+	0x00, 0x1d, 0xb5, 0xc7, 0x1b, 0x39, 0xe7, 0x10, 0xae, 0x51, 0x3d, 0x9d, 0x90, 0x38, 0x96, 0x54,
+	0x29, 0xb7, 0x65, 0x4a, 0x80, 0xea, 0xe9, 0x93, 0x32, 0xe3, 0xf4, 0xa1, 0xad, 0x12, 0xc6, 0x89,
+	0x3e, 0x93, 0xd4, 0xdd, 0x31, 0xf0, 0x45, 0x02, 0x7b, 0xe0, 0x6e, 0x8b, 0x19, 0x53, 0x95, 0x0b,
+	0xae, 0x28, 0xfe, 0x82, 0x0c, 0xf8, 0x94, 0x68, 0x72, 0x22, 0xb2, 0x2c, 0xd1, 0x19, 0xe5, 0x56,
+	0xf1, 0x46, 0x5b, 0xb4, 0xd5, 0xf6, 0xdf, 0x28, 0xf7, 0x01, 0x22, 0xcb, 0xbb, 0x92, 0xbe, 0x96,
+	0x71, 0x7a, 0xd0, 0xa6, 0x3c, 0x9e, 0x84, 0xa9, 0x88, 0x5e, 0xb9, 0xbb, 0xe6, 0xe1, 0xfe, 0xa7,
+	0x3c, 0x3e, 0x2e, 0x62, 0x8c, 0x61, 0x70, 0x95, 0x76, 0x6b, 0xf0, 0x33, 0x82, 0xee, 0x48, 0xb1,
+	0x31, 0x65, 0x89, 0xd2, 0x54, 0x3e, 0x3b, 0x1d, 0x55, 0xd4, 0xf7, 0xe1, 0xd6, 0x9c, 0xa4, 0x49,
+	0x5c, 0x08, 0xb5, 0x0a, 0x4b, 0x97, 0x7b, 0x16, 0xa8, 0x8a, 0x1f, 0x42, 0x67, 0xdd, 0x98, 0xad,
+	0x2f, 0x4d, 0xef, 0xaf, 0x63, 0xd5, 0x95, 0xc2, 0xfb, 0x3c, 0xbb, 0xe4, 0x7d, 0x9e, 0xfd, 0xdd,
+	0xd4, 0x0e, 0xe1, 0xa0, 0x56, 0x77, 0xe5, 0xec, 0xd1, 0xaf, 0x26, 0xb4, 0x46, 0x8a, 0x39, 0x21,
+	0xdc, 0xd8, 0x5c, 0xb4, 0xee, 0x70, 0xc6, 0xc2, 0xe1, 0xf6, 0xc8, 0xbd, 0x83, 0xda, 0xb4, 0x7d,
+	0xa8, 0xde, 0xbb, 0x6f, 0x3f, 0x3f, 0x35, 0xbb, 0x78, 0x3f, 0x28, 0xbe, 0x82, 0xb9, 0xa9, 0x99,
+	0x44, 0xab, 0x96, 0xef, 0x11, 0x74, 0xeb, 0x77, 0xc4, 0x76, 0xad, 0x85, 0xbd, 0x7b, 0x7f, 0x84,
+	0x2d, 0xf9, 0x5d, 0x43, 0xee, 0xe3, 0xbe, 0x21, 0x8f, 0x89, 0x26, 0x93, 0x8b, 0x25, 0xb0, 0x2a,
+	0x5e, 0x83, 0x53, 0x33, 0x47, 0xaf, 0xa2, 0xb8, 0x8c, 0x79, 0xf8, 0x6a, 0xcc, 0x72, 0xdf, 0x31,
+	0xdc, 0x3d, 0x7c, 0xdb, 0x70, 0xcb, 0x55, 0xe1, 0x64, 0x6d, 0x76, 0xc7, 0xcf, 0xbf, 0x2e, 0x7c,
+	0x74, 0xbe, 0xf0, 0xd1, 0x8f, 0x85, 0x8f, 0x3e, 0x2e, 0xfd, 0xc6, 0xf9, 0xd2, 0x6f, 0x7c, 0x5f,
+	0xfa, 0x8d, 0x17, 0x01, 0x4b, 0xf4, 0xf4, 0x2c, 0x1c, 0x46, 0x22, 0x0b, 0x22, 0x9a, 0x52, 0xa5,
+	0x13, 0x22, 0x24, 0xb3, 0xe7, 0x07, 0x24, 0xcf, 0x83, 0x37, 0xa6, 0xb3, 0x7e, 0x9b, 0x53, 0x15,
+	0xfe, 0x67, 0xfe, 0x10, 0x8f, 0x7f, 0x0f, 0x00, 0xf0, 0x17, 0xfb, 0x17, 0x6c, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -278,6 +461,9 @@ type MsgClient interface {
 	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
 	// DataCommitmentConfirm allows the validators to submit a confirmation for a data commitment.
 	DataCommitmentConfirm(ctx context.Context, in *MsgDataCommitmentConfirm, opts ...grpc.CallOption) (*MsgDataCommitmentConfirmResponse, error)
+	// RegisterEVMAddress binds a validator to the orchestrator account and the
+	// Ethereum address that it uses to sign QGB confirms.
+	RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAddress, opts ...grpc.CallOption) (*MsgRegisterEVMAddressResponse, error)
 }
 
 type msgClient struct {
@@ -306,12 +492,24 @@ func (c *msgClient) DataCommitmentConfirm(ctx context.Context, in *MsgDataCommit
 	return out, nil
 }
 
+func (c *msgClient) RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAddress, opts ...grpc.CallOption) (*MsgRegisterEVMAddressResponse, error) {
+	out := new(MsgRegisterEVMAddressResponse)
+	err := c.cc.Invoke(ctx, "/qgb.Msg/RegisterEVMAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ValsetConfirm allows the validators to submit their signatures over the validator set.
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
 	// DataCommitmentConfirm allows the validators to submit a confirmation for a data commitment.
 	DataCommitmentConfirm(context.Context, *MsgDataCommitmentConfirm) (*MsgDataCommitmentConfirmResponse, error)
+	// RegisterEVMAddress binds a validator to the orchestrator account and the
+	// Ethereum address that it uses to sign QGB confirms.
+	RegisterEVMAddress(context.Context, *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +522,9 @@ func (*UnimplementedMsgServer) ValsetConfirm(ctx context.Context, req *MsgValset
 func (*UnimplementedMsgServer) DataCommitmentConfirm(ctx context.Context, req *MsgDataCommitmentConfirm) (*MsgDataCommitmentConfirmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentConfirm not implemented")
 }
+func (*UnimplementedMsgServer) RegisterEVMAddress(ctx context.Context, req *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEVMAddress not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +566,24 @@ func _Msg_DataCommitmentConfirm_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterEVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterEVMAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterEVMAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qgb.Msg/RegisterEVMAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterEVMAddress(ctx, req.(*MsgRegisterEVMAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "qgb.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -377,6 +596,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DataCommitmentConfirm",
 			Handler:    _Msg_DataCommitmentConfirm_Handler,
 		},
+		{
+			MethodName: "RegisterEVMAddress",
+			Handler:    _Msg_RegisterEVMAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "qgb/msgs.proto",
@@ -474,6 +697,39 @@ func (m *MsgDataCommitmentConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -500,6 +756,80 @@ func (m *MsgDataCommitmentConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterEVMAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterEVMAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterEVMAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterEVMAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -550,6 +880,25 @@ func (m *MsgDataCommitmentConfirm) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovMsgs(uint64(m.EndBlock))
+	}
 	return n
 }
 
@@ -562,6 +911,40 @@ func (m *MsgDataCommitmentConfirmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterEVMAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -812,21 +1195,168 @@ func (m *MsgDataCommitmentConfirm) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgDataCommitmentConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -883,6 +1413,234 @@ func (m *MsgDataCommitmentConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterEVMAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterEVMAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterEVMAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterEVMAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterEVMAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
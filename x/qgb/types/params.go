@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyValsetPowerChangeThreshold = []byte("ValsetPowerChangeThreshold")
+	KeyEVMAddressRotationDelay    = []byte("EVMAddressRotationDelay")
+)
+
+var (
+	// DefaultValsetPowerChangeThreshold is the default portion of the
+	// normalized voting power that has to change for a new valset to be
+	// created
+	DefaultValsetPowerChangeThreshold = sdk.NewDecWithPrec(5, 2)
+	// DefaultEVMAddressRotationDelay is the default number of blocks after
+	// which a new registration of a registered validator becomes active
+	DefaultEVMAddressRotationDelay = uint64(1000)
+)
+
+// ParamKeyTable returns the param key table for the qgb module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(valsetPowerChangeThreshold sdk.Dec, evmAddressRotationDelay uint64) Params {
+	return Params{
+		ValsetPowerChangeThreshold: valsetPowerChangeThreshold,
+		EvmAddressRotationDelay:    evmAddressRotationDelay,
+	}
+}
+
+// DefaultParams returns the default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultValsetPowerChangeThreshold, DefaultEVMAddressRotationDelay)
+}
+
+// ParamSetPairs gets the list of param key-value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyValsetPowerChangeThreshold, &p.ValsetPowerChangeThreshold, validateValsetPowerChangeThreshold),
+		paramtypes.NewParamSetPair(KeyEVMAddressRotationDelay, &p.EvmAddressRotationDelay, validateEVMAddressRotationDelay),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateValsetPowerChangeThreshold(p.ValsetPowerChangeThreshold); err != nil {
+		return err
+	}
+	return validateEVMAddressRotationDelay(p.EvmAddressRotationDelay)
+}
+
+func validateValsetPowerChangeThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("valset power change threshold must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateEVMAddressRotationDelay(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
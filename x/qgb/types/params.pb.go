@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qgb/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the qgb module.
+type Params struct {
+	// ValsetPowerChangeThreshold is the portion of the normalized voting power
+	// that has to change since the latest valset for a new valset to be
+	// created
+	ValsetPowerChangeThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=valset_power_change_threshold,json=valsetPowerChangeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_change_threshold" yaml:"valset_power_change_threshold"`
+	// EVMAddressRotationDelay is the number of blocks after which a new
+	// registration of a validator that is already registered becomes active
+	EvmAddressRotationDelay uint64 `protobuf:"varint,2,opt,name=evm_address_rotation_delay,json=evmAddressRotationDelay,proto3" json:"evm_address_rotation_delay,omitempty" yaml:"evm_address_rotation_delay"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abfd64bbae636d89, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEvmAddressRotationDelay() uint64 {
+	if m != nil {
+		return m.EvmAddressRotationDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "qgb.Params")
+}
+
+func init() { proto.RegisterFile("qgb/params.proto", fileDescriptor_abfd64bbae636d89) }
+
+var fileDescriptor_abfd64bbae636d89 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x06, 0xe0, 0xb8, 0xf7, 0xaa, 0x12, 0x99, 0x50, 0x85, 0x44, 0x55, 0x09, 0xa7, 0x44, 0x80,
+	0xba, 0xb4, 0x1e, 0xd8, 0xd8, 0x28, 0x5d, 0xd8, 0xaa, 0x0a, 0x31, 0xb0, 0x58, 0x4e, 0x72, 0xe4,
+	0x54, 0xc4, 0x3d, 0xae, 0x6d, 0x0a, 0x7d, 0x03, 0x46, 0x56, 0xde, 0xa8, 0x63, 0x47, 0xc4, 0x10,
+	0xa1, 0xe6, 0x0d, 0xfa, 0x04, 0x28, 0x49, 0x41, 0x2c, 0x30, 0xf9, 0x58, 0xdf, 0x91, 0xad, 0xff,
+	0xf7, 0xf7, 0xe7, 0x32, 0x62, 0x5a, 0x18, 0xa1, 0xec, 0x40, 0x1b, 0x74, 0xd8, 0xfa, 0x37, 0x97,
+	0x51, 0xe7, 0x40, 0xa2, 0xc4, 0xea, 0xce, 0xca, 0xa9, 0xa6, 0xf0, 0xb9, 0xe1, 0x37, 0xc7, 0xd5,
+	0x6e, 0xeb, 0x95, 0xf8, 0x47, 0x0b, 0x91, 0x59, 0x70, 0x5c, 0xe3, 0x23, 0x18, 0x1e, 0xa7, 0x62,
+	0x26, 0x81, 0xbb, 0xd4, 0x80, 0x4d, 0x31, 0x4b, 0xda, 0xa4, 0x4b, 0x7a, 0x7b, 0xc3, 0xdb, 0x55,
+	0x1e, 0x78, 0xef, 0x79, 0x70, 0x26, 0xa7, 0x2e, 0x7d, 0x88, 0x06, 0x31, 0x2a, 0x16, 0xa3, 0x55,
+	0x68, 0x77, 0x47, 0xdf, 0x26, 0xf7, 0xcc, 0x2d, 0x35, 0xd8, 0xc1, 0x08, 0xe2, 0x6d, 0x1e, 0x9c,
+	0x2c, 0x85, 0xca, 0x2e, 0xc2, 0x3f, 0x1f, 0x0f, 0x27, 0x9d, 0xda, 0xc7, 0x25, 0x5f, 0x55, 0x7a,
+	0xf3, 0x85, 0xad, 0xc8, 0xef, 0xc0, 0x42, 0x71, 0x91, 0x24, 0x06, 0xac, 0xe5, 0x06, 0x9d, 0x70,
+	0x53, 0x9c, 0xf1, 0x04, 0x32, 0xb1, 0x6c, 0x37, 0xba, 0xa4, 0xf7, 0x7f, 0x78, 0xba, 0xcd, 0x83,
+	0xe3, 0xfa, 0xa7, 0xdf, 0x77, 0xc3, 0xc9, 0x21, 0x2c, 0xd4, 0x65, 0x6d, 0x93, 0x1d, 0x8d, 0x4a,
+	0x19, 0x5e, 0xaf, 0x36, 0x94, 0xac, 0x37, 0x94, 0x7c, 0x6c, 0x28, 0x79, 0x29, 0xa8, 0xb7, 0x2e,
+	0xa8, 0xf7, 0x56, 0x50, 0xef, 0x8e, 0xfd, 0x4c, 0x0a, 0x19, 0x58, 0x37, 0x15, 0x68, 0xe4, 0xf7,
+	0xdc, 0x17, 0x5a, 0xb3, 0x27, 0x56, 0xf6, 0x5e, 0xc5, 0x8e, 0x9a, 0x55, 0xb9, 0xe7, 0x9f, 0x03,
+	0x00, 0x45, 0x11, 0xa9, 0x00, 0x8b, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvmAddressRotationDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvmAddressRotationDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ValsetPowerChangeThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValsetPowerChangeThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EvmAddressRotationDelay != 0 {
+		n += 1 + sovParams(uint64(m.EvmAddressRotationDelay))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerChangeThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddressRotationDelay", wireType)
+			}
+			m.EvmAddressRotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmAddressRotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
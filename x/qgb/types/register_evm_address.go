@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// registerEVMAddressDomain separates the hashes signed for registrations from
+// the other hashes signed by the Ethereum key
+const registerEVMAddressDomain = "celestia-qgb-register-evm-address"
+
+var _ sdk.Msg = &MsgRegisterEVMAddress{}
+
+// NewMsgRegisterEVMAddress creates a new MsgRegisterEVMAddress
+func NewMsgRegisterEVMAddress(val sdk.ValAddress, orch sdk.AccAddress, evmAddress string, signature string) *MsgRegisterEVMAddress {
+	return &MsgRegisterEVMAddress{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orch.String(),
+		EvmAddress:          evmAddress,
+		Signature:           signature,
+	}
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRegisterEVMAddress) GetSigners() []sdk.AccAddress {
+	val, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(val)}
+}
+
+// ValidateBasic performs stateless validity checks on the msg
+func (msg *MsgRegisterEVMAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return fmt.Errorf("invalid orchestrator address: %w", err)
+	}
+	if err := ValidateEthAddress(msg.EvmAddress); err != nil {
+		return err
+	}
+	_, err := DecodeEthSignature(msg.Signature)
+	return err
+}
+
+// RegisterEVMAddressHash returns the hash that the Ethereum key signs to
+// register its address for the validator and orchestrator. It includes the
+// chain ID so that the signature can't be replayed on another chain.
+func RegisterEVMAddressHash(chainID string, val sdk.ValAddress, orch sdk.AccAddress) []byte {
+	return Keccak256(
+		[]byte(registerEVMAddressDomain),
+		address.MustLengthPrefix(val),
+		address.MustLengthPrefix(orch),
+		[]byte(chainID),
+	)
+}
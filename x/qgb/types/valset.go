@@ -0,0 +1,76 @@
+package types
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TotalPower is the sum of the normalized powers of the members of a valset
+const TotalPower = uint64(math.MaxUint32)
+
+// NewValset creates a valset from the validators' voting powers, normalizing
+// them so that they add up to TotalPower. Members are sorted by decreasing
+// power, and then by Ethereum address.
+func NewValset(nonce, height uint64, members []BridgeValidator) Valset {
+	var total uint64
+	for _, m := range members {
+		total += m.Power
+	}
+
+	normalized := make([]BridgeValidator, len(members))
+	for i, m := range members {
+		normalized[i] = BridgeValidator{EthAddress: m.EthAddress}
+		if total != 0 {
+			normalized[i].Power = sdk.NewUint(m.Power).MulUint64(TotalPower).QuoUint64(total).Uint64()
+		}
+	}
+
+	sort.SliceStable(normalized, func(i, j int) bool {
+		if normalized[i].Power != normalized[j].Power {
+			return normalized[i].Power > normalized[j].Power
+		}
+		return normalized[i].EthAddress < normalized[j].EthAddress
+	})
+
+	return Valset{Nonce: nonce, Members: normalized, Height: height}
+}
+
+// HasMember returns true if the Ethereum address is the address of a member
+// of the valset. Addresses are compared case insensitively, since their case
+// is only a checksum.
+func (v Valset) HasMember(ethAddress string) bool {
+	for _, m := range v.Members {
+		if strings.EqualFold(m.EthAddress, ethAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+// PowerDiff returns the portion of the normalized power that differs between
+// the two valsets. Members are matched by their Ethereum address, regardless
+// of its case, so the power of members that are only in one of the valsets
+// counts entirely.
+func (v Valset) PowerDiff(other Valset) sdk.Dec {
+	powers := make(map[common.Address]int64, len(v.Members))
+	for _, m := range v.Members {
+		powers[common.HexToAddress(m.EthAddress)] = int64(m.Power)
+	}
+	for _, m := range other.Members {
+		powers[common.HexToAddress(m.EthAddress)] -= int64(m.Power)
+	}
+
+	var diff uint64
+	for _, p := range powers {
+		if p < 0 {
+			p = -p
+		}
+		diff += uint64(p)
+	}
+
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(diff)).QuoInt64(int64(TotalPower))
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValset(t *testing.T) {
+	valset := types.NewValset(1, 10, []types.BridgeValidator{
+		{Power: 1, EthAddress: "0x02"},
+		{Power: 2, EthAddress: "0x03"},
+		{Power: 1, EthAddress: "0x01"},
+	})
+
+	require.Len(t, valset.Members, 3)
+	// sorted by decreasing power and then by address
+	assert.Equal(t, "0x03", valset.Members[0].EthAddress)
+	assert.Equal(t, "0x01", valset.Members[1].EthAddress)
+	assert.Equal(t, "0x02", valset.Members[2].EthAddress)
+
+	// normalized to add up to TotalPower, rounding down
+	assert.Equal(t, types.TotalPower/2, valset.Members[0].Power)
+	assert.Equal(t, types.TotalPower/4, valset.Members[1].Power)
+	var total uint64
+	for _, m := range valset.Members {
+		total += m.Power
+	}
+	assert.LessOrEqual(t, types.TotalPower-total, uint64(len(valset.Members)))
+}
+
+func TestValsetPowerDiff(t *testing.T) {
+	type test struct {
+		name     string
+		a, b     []types.BridgeValidator
+		expected sdk.Dec
+	}
+
+	tests := []test{
+		{
+			name:     "same valset",
+			a:        []types.BridgeValidator{{Power: 1, EthAddress: "0x01"}, {Power: 1, EthAddress: "0x02"}},
+			b:        []types.BridgeValidator{{Power: 5, EthAddress: "0x01"}, {Power: 5, EthAddress: "0x02"}},
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name:     "power moved between members",
+			a:        []types.BridgeValidator{{Power: 1, EthAddress: "0x01"}, {Power: 1, EthAddress: "0x02"}},
+			b:        []types.BridgeValidator{{Power: 3, EthAddress: "0x01"}, {Power: 1, EthAddress: "0x02"}},
+			expected: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:     "completely different members",
+			a:        []types.BridgeValidator{{Power: 1, EthAddress: "0x01"}},
+			b:        []types.BridgeValidator{{Power: 1, EthAddress: "0x02"}},
+			expected: sdk.NewDec(2),
+		},
+		{
+			name: "same members with differently cased addresses",
+			a: []types.BridgeValidator{
+				{Power: 1, EthAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+				{Power: 1, EthAddress: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+			},
+			b: []types.BridgeValidator{
+				{Power: 1, EthAddress: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+				{Power: 1, EthAddress: "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359"},
+			},
+			expected: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tt := range tests {
+		diff := types.NewValset(1, 1, tt.a).PowerDiff(types.NewValset(2, 2, tt.b))
+		// normalization rounds down, so allow a tiny difference
+		assert.True(t, diff.Sub(tt.expected).Abs().LT(sdk.NewDecWithPrec(1, 8)), "%s: got %s", tt.name, diff)
+	}
+}
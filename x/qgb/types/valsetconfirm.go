@@ -1,12 +1,26 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var _ sdk.Msg = &MsgValsetConfirm{}
+
+// NewMsgValsetConfirm creates a new MsgValsetConfirm
+func NewMsgValsetConfirm(nonce uint64, orchestrator sdk.AccAddress, ethAddress string, signature string) *MsgValsetConfirm {
+	return &MsgValsetConfirm{
+		Nonce:        nonce,
+		Orchestrator: orchestrator.String(),
+		EthAddress:   ethAddress,
+		Signature:    signature,
+	}
+}
+
 // GetSigners defines whose signature is required
 func (msg *MsgValsetConfirm) GetSigners() []sdk.AccAddress {
-	// TODO: figure out how to convert between AccAddress and ValAddress properly
+	// the orchestrator signs on behalf of the validator it is registered for
 	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
 		panic(err)
@@ -14,8 +28,16 @@ func (msg *MsgValsetConfirm) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
-// ValidateBasic
+// ValidateBasic performs stateless validity checks on the msg
 func (msg *MsgValsetConfirm) ValidateBasic() error {
-	// TODO
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return fmt.Errorf("invalid orchestrator: %w", err)
+	}
+	if err := ValidateEthAddress(msg.EthAddress); err != nil {
+		return err
+	}
+	if _, err := DecodeEthSignature(msg.Signature); err != nil {
+		return err
+	}
 	return nil
 }
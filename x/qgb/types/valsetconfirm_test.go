@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/qgb/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const ethAddress = "0x91DEd26b5f38B065FC0204c7929Da1b2A21877Ad"
+
+// signature is well formed, but isn't a valid signature of anything
+var signature = "0x" + strings.Repeat("01", types.EthSignatureLength)
+
+func TestMsgValsetConfirmValidateBasic(t *testing.T) {
+	orch := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	assert.NoError(t, types.NewMsgValsetConfirm(1, orch, ethAddress, signature).ValidateBasic())
+	assert.ErrorIs(t, types.NewMsgValsetConfirm(1, orch, ethAddress[2:], signature).ValidateBasic(), types.ErrInvalidEthAddress)
+	assert.ErrorIs(t, types.NewMsgValsetConfirm(1, orch, ethAddress[:40], signature).ValidateBasic(), types.ErrInvalidEthAddress)
+	assert.ErrorIs(t, types.NewMsgValsetConfirm(1, orch, ethAddress, "").ValidateBasic(), types.ErrInvalidEthSignature)
+	assert.ErrorIs(t, types.NewMsgValsetConfirm(1, orch, ethAddress, "signature").ValidateBasic(), types.ErrInvalidEthSignature)
+	assert.ErrorIs(t, types.NewMsgValsetConfirm(1, orch, ethAddress, signature[:130]).ValidateBasic(), types.ErrInvalidEthSignature)
+	assert.Error(t, (&types.MsgValsetConfirm{Nonce: 1, EthAddress: ethAddress, Signature: signature}).ValidateBasic())
+}